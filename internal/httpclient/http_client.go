@@ -0,0 +1,113 @@
+// Copyright (c) 2024 Futurice Oy
+// SPDX-License-Identifier: MPL-2.0
+
+package httpclient
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// Config describes the transport settings of the HTTP client used to access the Dependency-Track API.
+type Config struct {
+	// CACertPEM contains PEM encoded CA certificates to trust in addition to the system certificate pool.
+	CACertPEM string
+	// ClientCertPEM and ClientKeyPEM contain a PEM encoded client certificate and its private key for mutual TLS.
+	ClientCertPEM string
+	ClientKeyPEM  string
+	// InsecureSkipVerify disables the verification of the server certificate.
+	InsecureSkipVerify bool
+	// ProxyURL is the URL of the HTTP proxy to use. If empty, the proxy is taken from the environment.
+	ProxyURL string
+	// Timeout is the time limit for a single attempt of a request.
+	Timeout time.Duration
+	// MaxRetries is the number of times an idempotent request failing with a transient error is retried.
+	MaxRetries int
+	// RetryWaitMin and RetryWaitMax bound the exponential backoff between retries. A Retry-After header in the
+	// response takes precedence, up to RetryWaitMax. The caller validates that RetryWaitMin does not exceed
+	// RetryWaitMax, otherwise RetryWaitMax is used.
+	RetryWaitMin time.Duration
+	RetryWaitMax time.Duration
+	// LogBodies adds the request and response bodies to the HTTP log, with credentials masked.
+	LogBodies bool
+}
+
+// New creates an HTTP client with the given transport settings.
+func New(config Config) (*http.Client, error) {
+	if config.MaxRetries < 0 || config.RetryWaitMin < 0 || config.RetryWaitMax < 0 {
+		return nil, errors.New("the retry count and waits must not be negative")
+	}
+
+	tlsConfig, err := newTLSConfig(config)
+	if err != nil {
+		return nil, err
+	}
+
+	defaultTransport, ok := http.DefaultTransport.(*http.Transport)
+	if !ok {
+		return nil, errors.New("the default HTTP transport is not an *http.Transport")
+	}
+
+	transport := defaultTransport.Clone()
+	transport.TLSClientConfig = tlsConfig
+
+	if config.ProxyURL != "" {
+		proxyURL, err := url.Parse(config.ProxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proxy URL: %w", err)
+		}
+
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	// The timeout is applied by retryTransport to each attempt, so http.Client.Timeout is not set
+	return &http.Client{
+		Transport: &retryTransport{
+			base:         &loggingTransport{base: transport, logBodies: config.LogBodies},
+			maxRetries:   config.MaxRetries,
+			retryWaitMin: config.RetryWaitMin,
+			retryWaitMax: config.RetryWaitMax,
+			timeout:      config.Timeout,
+		},
+	}, nil
+}
+
+func newTLSConfig(config Config) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: config.InsecureSkipVerify, //nolint:gosec // Explicitly requested by the user, e.g. for lab instances
+	}
+
+	if config.CACertPEM != "" {
+		rootCAs, err := x509.SystemCertPool()
+		if err != nil {
+			rootCAs = x509.NewCertPool()
+		}
+
+		if !rootCAs.AppendCertsFromPEM([]byte(config.CACertPEM)) {
+			return nil, errors.New("no valid PEM encoded certificates found in the CA certificate")
+		}
+
+		tlsConfig.RootCAs = rootCAs
+	}
+
+	if (config.ClientCertPEM == "") != (config.ClientKeyPEM == "") {
+		return nil, errors.New("both the client certificate and the client key must be given for mutual TLS")
+	}
+
+	if config.ClientCertPEM != "" {
+		clientCert, err := tls.X509KeyPair([]byte(config.ClientCertPEM), []byte(config.ClientKeyPEM))
+		if err != nil {
+			return nil, fmt.Errorf("invalid client certificate or key: %w", err)
+		}
+
+		tlsConfig.Certificates = []tls.Certificate{clientCert}
+	}
+
+	return tlsConfig, nil
+}
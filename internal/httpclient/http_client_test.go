@@ -0,0 +1,218 @@
+// Copyright (c) 2024 Futurice Oy
+// SPDX-License-Identifier: MPL-2.0
+
+package httpclient_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/futurice/terraform-provider-dependencytrack/internal/httpclient"
+)
+
+func TestNew_untrustedServer(t *testing.T) {
+	server := httptest.NewTLSServer(okHandler())
+	defer server.Close()
+
+	client := newClient(t, httpclient.Config{})
+
+	if err := get(client, server.URL); err == nil {
+		t.Errorf("Error expected for a server with an untrusted certificate, but received none")
+	}
+}
+
+func TestNew_caCert(t *testing.T) {
+	server := httptest.NewTLSServer(okHandler())
+	defer server.Close()
+
+	client := newClient(t, httpclient.Config{
+		CACertPEM: encodeCertificate(server.Certificate().Raw),
+	})
+
+	if err := get(client, server.URL); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
+
+func TestNew_invalidCACert(t *testing.T) {
+	_, err := httpclient.New(httpclient.Config{
+		CACertPEM: "not a certificate",
+	})
+
+	if err == nil {
+		t.Errorf("Error expected, but received none")
+	}
+}
+
+func TestNew_insecureSkipVerify(t *testing.T) {
+	server := httptest.NewTLSServer(okHandler())
+	defer server.Close()
+
+	client := newClient(t, httpclient.Config{
+		InsecureSkipVerify: true,
+	})
+
+	if err := get(client, server.URL); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
+
+func TestNew_clientCert(t *testing.T) {
+	clientCertPEM, clientKeyPEM, clientCert := createClientCertificate(t)
+
+	clientCAs := x509.NewCertPool()
+	clientCAs.AddCert(clientCert)
+
+	server := httptest.NewUnstartedServer(okHandler())
+	server.TLS = &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ClientAuth: tls.RequireAndVerifyClientCert,
+		ClientCAs:  clientCAs,
+	}
+	server.StartTLS()
+	defer server.Close()
+
+	caCertPEM := encodeCertificate(server.Certificate().Raw)
+
+	clientWithoutCert := newClient(t, httpclient.Config{
+		CACertPEM: caCertPEM,
+	})
+	if err := get(clientWithoutCert, server.URL); err == nil {
+		t.Errorf("Error expected without a client certificate, but received none")
+	}
+
+	clientWithCert := newClient(t, httpclient.Config{
+		CACertPEM:     caCertPEM,
+		ClientCertPEM: clientCertPEM,
+		ClientKeyPEM:  clientKeyPEM,
+	})
+	if err := get(clientWithCert, server.URL); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
+
+func TestNew_clientCertWithoutKey(t *testing.T) {
+	clientCertPEM, _, _ := createClientCertificate(t)
+
+	_, err := httpclient.New(httpclient.Config{
+		ClientCertPEM: clientCertPEM,
+	})
+
+	if err == nil {
+		t.Errorf("Error expected, but received none")
+	}
+}
+
+func TestNew_proxy(t *testing.T) {
+	var proxiedHost string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxiedHost = r.Host
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer proxy.Close()
+
+	client := newClient(t, httpclient.Config{
+		ProxyURL: proxy.URL,
+	})
+
+	if err := get(client, "http://dependencytrack.invalid/api/version"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if proxiedHost != "dependencytrack.invalid" {
+		t.Errorf("Request was not sent through the proxy, proxy got host [%s]", proxiedHost)
+	}
+}
+
+func TestNew_timeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(200 * time.Millisecond)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := newClient(t, httpclient.Config{
+		Timeout: 10 * time.Millisecond,
+	})
+
+	if err := get(client, server.URL); err == nil {
+		t.Errorf("Error expected for a request exceeding the timeout, but received none")
+	}
+}
+
+func okHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+}
+
+func newClient(t *testing.T, config httpclient.Config) *http.Client {
+	t.Helper()
+
+	client, err := httpclient.New(config)
+	if err != nil {
+		t.Fatalf("Failed to create HTTP client: %v", err)
+	}
+
+	return client
+}
+
+func get(client *http.Client, url string) error {
+	resp, err := client.Get(url) //nolint:noctx // No need for a context in tests
+	if err != nil {
+		return err
+	}
+
+	return resp.Body.Close()
+}
+
+func encodeCertificate(der []byte) string {
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+func createClientCertificate(t *testing.T) (certPEM string, keyPEM string, cert *x509.Certificate) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("Failed to generate client key: %v", err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "terraform-provider-dependencytrack"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("Failed to create client certificate: %v", err)
+	}
+
+	cert, err = x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("Failed to parse client certificate: %v", err)
+	}
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("Failed to marshal client key: %v", err)
+	}
+
+	return encodeCertificate(der), string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})), cert
+}
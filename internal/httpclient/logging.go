@@ -0,0 +1,203 @@
+// Copyright (c) 2024 Futurice Oy
+// SPDX-License-Identifier: MPL-2.0
+
+package httpclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// LogSubsystem is the tflog subsystem of the HTTP traffic to the Dependency-Track API.
+	LogSubsystem = "http"
+	// logLevelEnvVarName sets the log level of the subsystem independently of TF_LOG and TF_LOG_PROVIDER.
+	logLevelEnvVarName = "TF_LOG_PROVIDER_DEPENDENCYTRACK_HTTP"
+
+	maskedValue = "***"
+)
+
+var (
+	// sensitiveHeaders are masked in the logged requests and responses.
+	sensitiveHeaders = []string{"X-Api-Key", "Authorization", "Cookie", "Set-Cookie"}
+
+	// sensitiveFields are the normalized names of JSON and form fields masked in the logged bodies.
+	sensitiveFields = map[string]bool{
+		"apikey":          true,
+		"key":             true,
+		"password":        true,
+		"newpassword":     true,
+		"confirmpassword": true,
+		"token":           true,
+		"secret":          true,
+	}
+
+	// sensitiveValueRegexes mask credentials wherever they appear in the log, e.g. in error messages.
+	sensitiveValueRegexes = []*regexp.Regexp{
+		// Dependency-Track API keys
+		regexp.MustCompile(`odt_[A-Za-z0-9_]+`),
+		// JSON Web Tokens, e.g. returned by the login
+		regexp.MustCompile(`eyJ[A-Za-z0-9_-]*\.[A-Za-z0-9_-]*\.[A-Za-z0-9_-]*`),
+	}
+)
+
+// loggingTransport logs the requests and responses with tflog, in the LogSubsystem subsystem at the debug level.
+// The bodies are only logged if logBodies is set. Credentials are masked.
+type loggingTransport struct {
+	base      http.RoundTripper
+	logBodies bool
+}
+
+func (t *loggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := newLogContext(req.Context())
+
+	requestFields := map[string]interface{}{
+		"http_method":  req.Method,
+		"http_url":     req.URL.String(),
+		"http_headers": maskHeaders(req.Header),
+	}
+	if t.logBodies && req.Body != nil && req.GetBody != nil {
+		if body, err := req.GetBody(); err == nil {
+			content, _ := io.ReadAll(body)
+			_ = body.Close()
+			requestFields["http_body"] = maskBody(req.Header.Get("Content-Type"), content)
+		}
+	}
+	tflog.SubsystemDebug(ctx, LogSubsystem, "Sending HTTP request", requestFields)
+
+	start := time.Now()
+	resp, err := t.base.RoundTrip(req)
+	duration := time.Since(start)
+
+	if err != nil {
+		tflog.SubsystemDebug(ctx, LogSubsystem, "HTTP request failed", map[string]interface{}{
+			"http_method": req.Method,
+			"http_url":    req.URL.String(),
+			"duration":    duration.String(),
+			"error":       err.Error(),
+		})
+		return nil, err
+	}
+
+	responseFields := map[string]interface{}{
+		"http_method":      req.Method,
+		"http_url":         req.URL.String(),
+		"http_status_code": resp.StatusCode,
+		"http_headers":     maskHeaders(resp.Header),
+		"duration":         duration.String(),
+	}
+	if t.logBodies {
+		content, err := io.ReadAll(resp.Body)
+		_ = resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		resp.Body = io.NopCloser(bytes.NewReader(content))
+		responseFields["http_body"] = maskBody(resp.Header.Get("Content-Type"), content)
+	}
+	tflog.SubsystemDebug(ctx, LogSubsystem, "Received HTTP response", responseFields)
+
+	return resp, nil
+}
+
+func newLogContext(ctx context.Context) context.Context {
+	ctx = tflog.NewSubsystem(ctx, LogSubsystem, tflog.WithLevelFromEnv(logLevelEnvVarName))
+	ctx = tflog.SubsystemMaskAllFieldValuesRegexes(ctx, LogSubsystem, sensitiveValueRegexes...)
+	ctx = tflog.SubsystemMaskMessageRegexes(ctx, LogSubsystem, sensitiveValueRegexes...)
+
+	return ctx
+}
+
+func maskHeaders(header http.Header) map[string]string {
+	masked := make(map[string]string, len(header))
+	for name, values := range header {
+		masked[name] = strings.Join(values, ", ")
+	}
+
+	for _, name := range sensitiveHeaders {
+		if header.Get(name) != "" {
+			masked[http.CanonicalHeaderKey(name)] = maskedValue
+		}
+	}
+
+	return masked
+}
+
+// maskBody returns the body as a string with the sensitive fields of JSON and form encoded bodies masked. Other
+// bodies are returned as is, and rely on sensitiveValueRegexes.
+func maskBody(contentType string, body []byte) string {
+	switch {
+	case strings.HasPrefix(contentType, "application/json"):
+		var value interface{}
+		if err := json.Unmarshal(body, &value); err != nil {
+			return string(body)
+		}
+
+		masked, err := json.Marshal(maskJSON(value))
+		if err != nil {
+			return string(body)
+		}
+
+		return string(masked)
+	case strings.HasPrefix(contentType, "application/x-www-form-urlencoded"):
+		values, err := url.ParseQuery(string(body))
+		if err != nil {
+			return string(body)
+		}
+
+		for name := range values {
+			if isSensitiveField(name) {
+				values.Set(name, maskedValue)
+			}
+		}
+
+		return values.Encode()
+	default:
+		return string(body)
+	}
+}
+
+func maskJSON(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for name, fieldValue := range v {
+			if isSensitiveField(name) {
+				v[name] = maskedValue
+				continue
+			}
+			v[name] = maskJSON(fieldValue)
+		}
+
+		// Values of encrypted config properties are secrets
+		if v["propertyType"] == "ENCRYPTEDSTRING" {
+			if _, ok := v["propertyValue"]; ok {
+				v["propertyValue"] = maskedValue
+			}
+		}
+
+		return v
+	case []interface{}:
+		for i := range v {
+			v[i] = maskJSON(v[i])
+		}
+
+		return v
+	default:
+		return v
+	}
+}
+
+func isSensitiveField(name string) bool {
+	normalized := strings.ToLower(strings.NewReplacer("_", "", "-", "").Replace(name))
+
+	return sensitiveFields[normalized]
+}
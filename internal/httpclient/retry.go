@@ -0,0 +1,174 @@
+// Copyright (c) 2024 Futurice Oy
+// SPDX-License-Identifier: MPL-2.0
+
+package httpclient
+
+import (
+	"context"
+	"io"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// retryTransport retries idempotent requests failing with a transient error, and limits the time of each attempt
+// to timeout.
+type retryTransport struct {
+	base         http.RoundTripper
+	maxRetries   int
+	retryWaitMin time.Duration
+	retryWaitMax time.Duration
+	timeout      time.Duration
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		resp, err := t.roundTripAttempt(req)
+
+		if attempt >= t.maxRetries || !isRetryable(req, resp, err) {
+			return resp, err
+		}
+
+		wait := t.backoff(attempt, resp)
+		if resp != nil {
+			drainBody(resp)
+		}
+
+		if !sleep(req.Context(), wait) {
+			return nil, req.Context().Err()
+		}
+
+		// A RoundTripper must not modify the request, so the body for the next attempt goes to a copy
+		if req.Body != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req = req.Clone(req.Context())
+			req.Body = body
+		}
+	}
+}
+
+func (t *retryTransport) roundTripAttempt(req *http.Request) (*http.Response, error) {
+	if t.timeout <= 0 {
+		return t.base.RoundTrip(req)
+	}
+
+	ctx, cancel := context.WithTimeout(req.Context(), t.timeout)
+	resp, err := t.base.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	// The attempt context must stay alive until the caller has read the body
+	resp.Body = &cancelOnCloseBody{ReadCloser: resp.Body, cancel: cancel}
+
+	return resp, nil
+}
+
+// backoff returns the time to wait before the next attempt. The Retry-After header of the response takes precedence
+// over the jittered exponential backoff, but is limited to the maximum wait, so that the server can not make the
+// provider wait for an arbitrary time.
+func (t *retryTransport) backoff(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if wait, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			return min(wait, t.retryWaitMax)
+		}
+	}
+
+	wait := t.retryWaitMin << attempt
+	if wait <= 0 || wait > t.retryWaitMax {
+		wait = t.retryWaitMax
+	}
+
+	// Wait at least half of the backoff, and a random share of the other half
+	half := int64(wait / 2)
+	if half <= 0 {
+		return wait
+	}
+
+	return time.Duration(half + rand.Int63n(half+1)) //nolint:gosec // No need for a cryptographically secure jitter
+}
+
+// isRetryable tells whether the request may be sent again. Only methods without side effects, and DELETE, are
+// retried. PUT is not, as Dependency-Track uses it to create objects.
+func isRetryable(req *http.Request, resp *http.Response, err error) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodDelete:
+	default:
+		return false
+	}
+
+	if req.Body != nil && req.GetBody == nil {
+		return false
+	}
+
+	if err != nil {
+		// Do not retry when the caller gave up on the request
+		return req.Context().Err() == nil
+	}
+
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
+
+// parseRetryAfter parses the Retry-After header given either in seconds or as an HTTP date.
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		wait := time.Until(date)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+
+	return 0, false
+}
+
+// sleep waits for the given time, or until ctx is done. It returns false if ctx was done first.
+func sleep(ctx context.Context, wait time.Duration) bool {
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
+
+// drainBody reads the rest of the body, so that the connection can be reused, and closes it.
+func drainBody(resp *http.Response) {
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+	_ = resp.Body.Close()
+}
+
+type cancelOnCloseBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnCloseBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
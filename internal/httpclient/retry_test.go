@@ -0,0 +1,236 @@
+// Copyright (c) 2024 Futurice Oy
+// SPDX-License-Identifier: MPL-2.0
+
+package httpclient_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/futurice/terraform-provider-dependencytrack/internal/httpclient"
+)
+
+// newFlakyServer starts a server responding to the first failures requests with the given status code, and with
+// 200 OK after that. It returns the server and a pointer to the number of requests received.
+func newFlakyServer(t *testing.T, failures int32, statusCode int, header http.Header) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if requests.Add(1) <= failures {
+			for name, values := range header {
+				w.Header()[name] = values
+			}
+			w.WriteHeader(statusCode)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+
+	return server, &requests
+}
+
+func retryConfig(maxRetries int) httpclient.Config {
+	return httpclient.Config{
+		MaxRetries:   maxRetries,
+		RetryWaitMin: time.Millisecond,
+		RetryWaitMax: 10 * time.Millisecond,
+	}
+}
+
+func TestRetry_transientStatusCodes(t *testing.T) {
+	for _, statusCode := range []int{http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout} {
+		t.Run(http.StatusText(statusCode), func(t *testing.T) {
+			server, requests := newFlakyServer(t, 2, statusCode, nil)
+			client := newClient(t, retryConfig(3))
+
+			resp, err := client.Get(server.URL) //nolint:noctx // No need for a context in tests
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			_ = resp.Body.Close()
+
+			if resp.StatusCode != http.StatusOK {
+				t.Errorf("Expected status 200 after retries, got %d", resp.StatusCode)
+			}
+			if requests.Load() != 3 {
+				t.Errorf("Expected 3 requests, got %d", requests.Load())
+			}
+		})
+	}
+}
+
+func TestRetry_givesUp(t *testing.T) {
+	server, requests := newFlakyServer(t, 10, http.StatusServiceUnavailable, nil)
+	client := newClient(t, retryConfig(2))
+
+	resp, err := client.Get(server.URL) //nolint:noctx // No need for a context in tests
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	_ = resp.Body.Close()
+
+	if resp.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("Expected the last response to be returned, got status %d", resp.StatusCode)
+	}
+	if requests.Load() != 3 {
+		t.Errorf("Expected 3 requests, got %d", requests.Load())
+	}
+}
+
+func TestRetry_disabledByDefault(t *testing.T) {
+	server, requests := newFlakyServer(t, 1, http.StatusServiceUnavailable, nil)
+	client := newClient(t, httpclient.Config{})
+
+	if err := get(client, server.URL); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if requests.Load() != 1 {
+		t.Errorf("Expected 1 request, got %d", requests.Load())
+	}
+}
+
+func TestRetry_nonTransientStatusCode(t *testing.T) {
+	server, requests := newFlakyServer(t, 1, http.StatusInternalServerError, nil)
+	client := newClient(t, retryConfig(3))
+
+	if err := get(client, server.URL); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if requests.Load() != 1 {
+		t.Errorf("Expected 1 request, got %d", requests.Load())
+	}
+}
+
+func TestRetry_delete(t *testing.T) {
+	server, requests := newFlakyServer(t, 1, http.StatusBadGateway, nil)
+	client := newClient(t, retryConfig(3))
+
+	req, err := http.NewRequest(http.MethodDelete, server.URL, nil) //nolint:noctx // No need for a context in tests
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	_ = resp.Body.Close()
+
+	if requests.Load() != 2 {
+		t.Errorf("Expected 2 requests, got %d", requests.Load())
+	}
+}
+
+func TestRetry_nonIdempotentMethods(t *testing.T) {
+	for _, method := range []string{http.MethodPost, http.MethodPut, http.MethodPatch} {
+		t.Run(method, func(t *testing.T) {
+			server, requests := newFlakyServer(t, 1, http.StatusServiceUnavailable, nil)
+			client := newClient(t, retryConfig(3))
+
+			req, err := http.NewRequest(method, server.URL, strings.NewReader("{}")) //nolint:noctx // No need for a context in tests
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			resp, err := client.Do(req)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			_ = resp.Body.Close()
+
+			if requests.Load() != 1 {
+				t.Errorf("Expected 1 request, got %d", requests.Load())
+			}
+		})
+	}
+}
+
+func TestRetry_retryAfter(t *testing.T) {
+	server, requests := newFlakyServer(t, 1, http.StatusTooManyRequests, http.Header{"Retry-After": []string{"1"}})
+	config := retryConfig(3)
+	config.RetryWaitMax = 2 * time.Second
+	client := newClient(t, config)
+
+	start := time.Now()
+	if err := get(client, server.URL); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if elapsed := time.Since(start); elapsed < time.Second {
+		t.Errorf("Retry-After was not honored, retried after %s", elapsed)
+	}
+	if requests.Load() != 2 {
+		t.Errorf("Expected 2 requests, got %d", requests.Load())
+	}
+}
+
+func TestRetry_retryAfterLimitedToMaximumWait(t *testing.T) {
+	server, requests := newFlakyServer(t, 1, http.StatusTooManyRequests, http.Header{"Retry-After": []string{"3600"}})
+	client := newClient(t, retryConfig(3))
+
+	start := time.Now()
+	if err := get(client, server.URL); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if elapsed := time.Since(start); elapsed > 10*time.Second {
+		t.Errorf("Retry-After was not limited to the maximum wait, retried after %s", elapsed)
+	}
+	if requests.Load() != 2 {
+		t.Errorf("Expected 2 requests, got %d", requests.Load())
+	}
+}
+
+func TestRetry_connectionReset(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if requests.Add(1) == 1 {
+			hijacker, ok := w.(http.Hijacker)
+			if !ok {
+				t.Errorf("Response writer does not support hijacking")
+				return
+			}
+			conn, _, err := hijacker.Hijack()
+			if err != nil {
+				t.Errorf("Failed to hijack the connection: %v", err)
+				return
+			}
+			_ = conn.Close()
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := newClient(t, retryConfig(3))
+
+	if err := get(client, server.URL); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if requests.Load() != 2 {
+		t.Errorf("Expected 2 requests, got %d", requests.Load())
+	}
+}
+
+func TestRetry_invalidSettings(t *testing.T) {
+	_, err := httpclient.New(httpclient.Config{
+		MaxRetries:   1,
+		RetryWaitMin: -time.Second,
+		RetryWaitMax: time.Second,
+	})
+
+	if err == nil {
+		t.Errorf("Error expected, but received none")
+	}
+}
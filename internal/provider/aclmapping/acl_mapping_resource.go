@@ -11,6 +11,7 @@ import (
 	"strings"
 
 	dtrack "github.com/futurice/dependency-track-client-go"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/providerdata"
 	"github.com/futurice/terraform-provider-dependencytrack/internal/utils"
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -29,7 +30,7 @@ func NewACLMappingResource() resource.Resource {
 
 // ACLMappingResource defines the resource implementation.
 type ACLMappingResource struct {
-	client *dtrack.Client
+	providerdata.ResourceBase
 }
 
 // ACLResourceModel describes the resource data model.
@@ -64,25 +65,6 @@ func (r *ACLMappingResource) Schema(ctx context.Context, req resource.SchemaRequ
 	}
 }
 
-func (r *ACLMappingResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if req.ProviderData == nil {
-		return
-	}
-
-	client, ok := req.ProviderData.(*dtrack.Client)
-
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *dtrack.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
-
-		return
-	}
-
-	r.client = client
-}
-
 func (r *ACLMappingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan, state ACLResourceModel
 
@@ -106,7 +88,7 @@ func (r *ACLMappingResource) Create(ctx context.Context, req resource.CreateRequ
 		Project: projectID,
 	}
 
-	err := r.client.ACLMapping.Create(ctx, mapping)
+	err := r.Client.ACLMapping.Create(ctx, mapping)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create ACL mapping, got error: %s", err))
 		return
@@ -137,7 +119,7 @@ func (r *ACLMappingResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	projectMappings, err := r.client.ACLMapping.Get(ctx, teamID)
+	projectMappings, err := r.Client.ACLMapping.Get(ctx, teamID)
 	if err != nil {
 		var apiErr *dtrack.APIError
 		if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
@@ -200,13 +182,13 @@ func (r *ACLMappingResource) Update(ctx context.Context, req resource.UpdateRequ
 		Project: oldProjectID,
 	}
 
-	err := r.client.ACLMapping.Create(ctx, newMapping)
+	err := r.Client.ACLMapping.Create(ctx, newMapping)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create new ACL mapping, got error: %s", err))
 		return
 	}
 
-	err = r.client.ACLMapping.Delete(ctx, oldMapping)
+	err = r.Client.ACLMapping.Delete(ctx, oldMapping)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete old ACL mapping, got error: %s", err))
 		return
@@ -242,7 +224,7 @@ func (r *ACLMappingResource) Delete(ctx context.Context, req resource.DeleteRequ
 		Project: projectID,
 	}
 
-	err := r.client.ACLMapping.Delete(ctx, mapping)
+	err := r.Client.ACLMapping.Delete(ctx, mapping)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete ACL mapping, got error: %s", err))
 		return
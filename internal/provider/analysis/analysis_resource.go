@@ -0,0 +1,362 @@
+// Copyright (c) 2024 Futurice Oy
+// SPDX-License-Identifier: MPL-2.0
+
+package analysis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	dtrack "github.com/futurice/dependency-track-client-go"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/providerdata"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/utils"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AnalysisResource{}
+var _ resource.ResourceWithImportState = &AnalysisResource{}
+
+// notSet is the value of the state, justification and response of a finding that has not been analysed.
+const notSet = "NOT_SET"
+
+var states = []string{"EXPLOITABLE", "IN_TRIAGE", "FALSE_POSITIVE", "NOT_AFFECTED", "RESOLVED"}
+
+// justifications are the impact analysis justifications of CycloneDX.
+var justifications = []string{
+	"CODE_NOT_PRESENT", "CODE_NOT_REACHABLE", "REQUIRES_CONFIGURATION", "REQUIRES_DEPENDENCY", "REQUIRES_ENVIRONMENT",
+	"PROTECTED_BY_COMPILER", "PROTECTED_AT_RUNTIME", "PROTECTED_AT_PERIMETER", "PROTECTED_BY_MITIGATING_CONTROL", notSet,
+}
+
+// responses are the vulnerability responses of CycloneDX.
+var responses = []string{"CAN_NOT_FIX", "WILL_NOT_FIX", "UPDATE", "ROLLBACK", "WORKAROUND_AVAILABLE", notSet}
+
+func NewAnalysisResource() resource.Resource {
+	return &AnalysisResource{}
+}
+
+// AnalysisResource defines the resource implementation.
+type AnalysisResource struct {
+	providerdata.ResourceBase
+}
+
+// AnalysisResourceModel describes the resource data model.
+type AnalysisResourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	ProjectID       types.String `tfsdk:"project_id"`
+	ComponentID     types.String `tfsdk:"component_id"`
+	VulnerabilityID types.String `tfsdk:"vulnerability_id"`
+	State           types.String `tfsdk:"state"`
+	Justification   types.String `tfsdk:"justification"`
+	Response        types.String `tfsdk:"response"`
+	Details         types.String `tfsdk:"details"`
+	Suppressed      types.Bool   `tfsdk:"suppressed"`
+	ResetOnDestroy  types.Bool   `tfsdk:"reset_on_destroy"`
+}
+
+func (r *AnalysisResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_analysis"
+}
+
+func (r *AnalysisResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Analysis of a finding, i.e. the triage decision on a vulnerability of a component in a project. " +
+			"Dependency-Track records the changes made by the resource in the audit trail of the finding.",
+
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "Project UUID",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"component_id": schema.StringAttribute{
+				MarkdownDescription: "Component UUID",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"vulnerability_id": schema.StringAttribute{
+				MarkdownDescription: "Vulnerability UUID",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"state": schema.StringAttribute{
+				MarkdownDescription: "Analysis state. Must be one of the following values: [" + strings.Join(states, ", ") + "]",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(states...),
+				},
+			},
+			"justification": schema.StringAttribute{
+				MarkdownDescription: "Justification of the analysis, typically used with the state NOT_AFFECTED. " +
+					"Must be one of the following values: [" + strings.Join(justifications, ", ") + "]. Default is " + notSet + ".",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(notSet),
+				Validators: []validator.String{
+					stringvalidator.OneOf(justifications...),
+				},
+			},
+			"response": schema.StringAttribute{
+				MarkdownDescription: "Vendor response to the vulnerability. " +
+					"Must be one of the following values: [" + strings.Join(responses, ", ") + "]. Default is " + notSet + ".",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(notSet),
+				Validators: []validator.String{
+					stringvalidator.OneOf(responses...),
+				},
+			},
+			"details": schema.StringAttribute{
+				MarkdownDescription: "Details of the analysis. Dependency-Track does not support clearing the details, " +
+					"so removing the attribute leaves the current details in place.",
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"suppressed": schema.BoolAttribute{
+				MarkdownDescription: "Whether the finding is suppressed. Default is false.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"reset_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: "Whether destroying the resource resets the analysis to " + notSet + " and unsuppresses " +
+					"the finding. If false, the analysis is left as is. Default is true.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Synthetic analysis ID in the form of project_id/component_id/vulnerability_id",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *AnalysisResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan AnalysisResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	analysisReq, diags := TFAnalysisToDTAnalysisRequest(plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	respAnalysis, err := r.Client.Analysis.Create(ctx, analysisReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create analysis, got error: %s", err))
+		return
+	}
+
+	plan.ID = types.StringValue(makeAnalysisID(analysisReq.Project, analysisReq.Component, analysisReq.Vulnerability))
+	updateTFAnalysis(&plan, respAnalysis)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *AnalysisResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state AnalysisResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID, componentID, vulnerabilityID, diags := parseFindingIDs(state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	respAnalysis, err := r.Client.Analysis.Get(ctx, componentID, projectID, vulnerabilityID)
+	if err != nil {
+		var apiErr *dtrack.APIError
+		if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read analysis, got error: %s", err))
+		return
+	}
+
+	state.ID = types.StringValue(makeAnalysisID(projectID, componentID, vulnerabilityID))
+	updateTFAnalysis(&state, respAnalysis)
+
+	// Not known when importing
+	if state.ResetOnDestroy.IsNull() {
+		state.ResetOnDestroy = types.BoolValue(true)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *AnalysisResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan AnalysisResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	analysisReq, diags := TFAnalysisToDTAnalysisRequest(plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	respAnalysis, err := r.Client.Analysis.Create(ctx, analysisReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update analysis, got error: %s", err))
+		return
+	}
+
+	updateTFAnalysis(&plan, respAnalysis)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *AnalysisResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state AnalysisResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !state.ResetOnDestroy.ValueBool() {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	projectID, componentID, vulnerabilityID, diags := parseFindingIDs(state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	suppressed := false
+	_, err := r.Client.Analysis.Create(ctx, dtrack.AnalysisRequest{
+		Project:       projectID,
+		Component:     componentID,
+		Vulnerability: vulnerabilityID,
+		State:         notSet,
+		Justification: notSet,
+		Response:      notSet,
+		Suppressed:    &suppressed,
+	})
+	if err != nil {
+		var apiErr *dtrack.APIError
+		if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
+			// The project, component or vulnerability no longer exists, so there is nothing to reset
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to reset analysis, got error: %s", err))
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *AnalysisResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 3 {
+		resp.Diagnostics.AddError("Invalid import ID", fmt.Sprintf("Expected ID in the format 'project_id/component_id/vulnerability_id', got [%s]", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("component_id"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("vulnerability_id"), parts[2])...)
+}
+
+func parseFindingIDs(tfAnalysis AnalysisResourceModel) (uuid.UUID, uuid.UUID, uuid.UUID, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	projectID, projectIDDiags := utils.ParseAttributeUUID(tfAnalysis.ProjectID.ValueString(), "project_id")
+	diags.Append(projectIDDiags...)
+
+	componentID, componentIDDiags := utils.ParseAttributeUUID(tfAnalysis.ComponentID.ValueString(), "component_id")
+	diags.Append(componentIDDiags...)
+
+	vulnerabilityID, vulnerabilityIDDiags := utils.ParseAttributeUUID(tfAnalysis.VulnerabilityID.ValueString(), "vulnerability_id")
+	diags.Append(vulnerabilityIDDiags...)
+
+	return projectID, componentID, vulnerabilityID, diags
+}
+
+func TFAnalysisToDTAnalysisRequest(tfAnalysis AnalysisResourceModel) (dtrack.AnalysisRequest, diag.Diagnostics) {
+	projectID, componentID, vulnerabilityID, diags := parseFindingIDs(tfAnalysis)
+
+	suppressed := tfAnalysis.Suppressed.ValueBool()
+
+	return dtrack.AnalysisRequest{
+		Project:       projectID,
+		Component:     componentID,
+		Vulnerability: vulnerabilityID,
+		State:         dtrack.AnalysisState(tfAnalysis.State.ValueString()),
+		Justification: dtrack.AnalysisJustification(tfAnalysis.Justification.ValueString()),
+		Response:      dtrack.AnalysisResponse(tfAnalysis.Response.ValueString()),
+		Details:       tfAnalysis.Details.ValueString(),
+		Suppressed:    &suppressed,
+	}, diags
+}
+
+// updateTFAnalysis sets the analysed values of tfAnalysis from dtAnalysis, so that changes made e.g. in the
+// user interface show up as drift.
+func updateTFAnalysis(tfAnalysis *AnalysisResourceModel, dtAnalysis dtrack.Analysis) {
+	tfAnalysis.State = types.StringValue(valueOrNotSet(string(dtAnalysis.State)))
+	tfAnalysis.Justification = types.StringValue(valueOrNotSet(string(dtAnalysis.Justification)))
+	tfAnalysis.Response = types.StringValue(valueOrNotSet(string(dtAnalysis.Response)))
+	tfAnalysis.Details = types.StringValue(dtAnalysis.Details)
+	tfAnalysis.Suppressed = types.BoolValue(dtAnalysis.Suppressed)
+}
+
+func valueOrNotSet(value string) string {
+	if value == "" {
+		return notSet
+	}
+
+	return value
+}
+
+func makeAnalysisID(projectID, componentID, vulnerabilityID uuid.UUID) string {
+	return fmt.Sprintf("%s/%s/%s", projectID.String(), componentID.String(), vulnerabilityID.String())
+}
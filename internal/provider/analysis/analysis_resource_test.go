@@ -0,0 +1,214 @@
+// Copyright (c) 2024 Futurice Oy
+// SPDX-License-Identifier: MPL-2.0
+
+package analysis_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	dtrack "github.com/futurice/dependency-track-client-go"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/testutils"
+	analysistestutils "github.com/futurice/terraform-provider-dependencytrack/internal/testutils/analysis"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+var testDependencyTrack *testutils.TestDependencyTrack
+
+func TestMain(m *testing.M) {
+	if os.Getenv(resource.EnvTfAcc) != "" {
+		var cleanup func()
+		testDependencyTrack, cleanup = testutils.InitTestDependencyTrack()
+		defer cleanup()
+	}
+
+	m.Run()
+}
+
+func TestAccAnalysisResource_basic(t *testing.T) {
+	ctx := testutils.CreateTestContext(t)
+
+	finding := analysistestutils.CreateTestFinding(ctx, t, testDependencyTrack)
+	analysisResourceName := analysistestutils.CreateAnalysisResourceName("test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAnalysisConfigBasic(testDependencyTrack, finding),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					analysistestutils.TestAccCheckAnalysisHasExpectedData(ctx, testDependencyTrack, finding, dtrack.Analysis{
+						State:         "IN_TRIAGE",
+						Justification: "NOT_SET",
+						Response:      "NOT_SET",
+					}),
+					resource.TestCheckResourceAttr(analysisResourceName, "id", fmt.Sprintf("%s/%s/%s", finding.ProjectID, finding.ComponentID, finding.VulnerabilityID)),
+					resource.TestCheckResourceAttr(analysisResourceName, "state", "IN_TRIAGE"),
+					resource.TestCheckResourceAttr(analysisResourceName, "justification", "NOT_SET"),
+					resource.TestCheckResourceAttr(analysisResourceName, "response", "NOT_SET"),
+					resource.TestCheckResourceAttr(analysisResourceName, "details", ""),
+					resource.TestCheckResourceAttr(analysisResourceName, "suppressed", "false"),
+					resource.TestCheckResourceAttr(analysisResourceName, "reset_on_destroy", "true"),
+				),
+			},
+			{
+				ResourceName:      analysisResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAnalysisConfigNotAffected(testDependencyTrack, finding, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					analysistestutils.TestAccCheckAnalysisHasExpectedData(ctx, testDependencyTrack, finding, dtrack.Analysis{
+						State:         "NOT_AFFECTED",
+						Justification: "CODE_NOT_REACHABLE",
+						Response:      "WILL_NOT_FIX",
+						Details:       "The vulnerable function is never called",
+						Suppressed:    true,
+					}),
+					resource.TestCheckResourceAttr(analysisResourceName, "state", "NOT_AFFECTED"),
+					resource.TestCheckResourceAttr(analysisResourceName, "justification", "CODE_NOT_REACHABLE"),
+					resource.TestCheckResourceAttr(analysisResourceName, "response", "WILL_NOT_FIX"),
+					resource.TestCheckResourceAttr(analysisResourceName, "details", "The vulnerable function is never called"),
+					resource.TestCheckResourceAttr(analysisResourceName, "suppressed", "true"),
+				),
+			},
+			{
+				Config: testAccAnalysisConfigNoAnalysis(testDependencyTrack),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					analysistestutils.TestAccCheckAnalysisHasExpectedData(ctx, testDependencyTrack, finding, dtrack.Analysis{
+						State:         "NOT_SET",
+						Justification: "NOT_SET",
+						Response:      "NOT_SET",
+						Details:       "The vulnerable function is never called",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAnalysisResource_keepOnDestroy(t *testing.T) {
+	ctx := testutils.CreateTestContext(t)
+
+	finding := analysistestutils.CreateTestFinding(ctx, t, testDependencyTrack)
+
+	expectedAnalysis := dtrack.Analysis{
+		State:         "NOT_AFFECTED",
+		Justification: "CODE_NOT_REACHABLE",
+		Response:      "WILL_NOT_FIX",
+		Details:       "The vulnerable function is never called",
+		Suppressed:    true,
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAnalysisConfigNotAffected(testDependencyTrack, finding, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					analysistestutils.TestAccCheckAnalysisHasExpectedData(ctx, testDependencyTrack, finding, expectedAnalysis),
+				),
+			},
+			{
+				Config: testAccAnalysisConfigNoAnalysis(testDependencyTrack),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					analysistestutils.TestAccCheckAnalysisHasExpectedData(ctx, testDependencyTrack, finding, expectedAnalysis),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAnalysisResource_drift(t *testing.T) {
+	ctx := testutils.CreateTestContext(t)
+
+	finding := analysistestutils.CreateTestFinding(ctx, t, testDependencyTrack)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAnalysisConfigBasic(testDependencyTrack, finding),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccAnalysisChangeOutsideTerraform(ctx, finding),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccAnalysisConfigBasic(testDependencyTrack, finding),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					analysistestutils.TestAccCheckAnalysisHasExpectedData(ctx, testDependencyTrack, finding, dtrack.Analysis{
+						State:         "IN_TRIAGE",
+						Justification: "NOT_SET",
+						Response:      "NOT_SET",
+					}),
+				),
+			},
+		},
+	})
+}
+
+// testAccAnalysisChangeOutsideTerraform changes the analysis like an analyst would in the user interface.
+func testAccAnalysisChangeOutsideTerraform(ctx context.Context, finding analysistestutils.Finding) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		suppressed := true
+		_, err := testDependencyTrack.Client.Analysis.Create(ctx, dtrack.AnalysisRequest{
+			Project:       finding.ProjectID,
+			Component:     finding.ComponentID,
+			Vulnerability: finding.VulnerabilityID,
+			State:         "FALSE_POSITIVE",
+			Suppressed:    &suppressed,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to change analysis of finding %v: %w", finding, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccAnalysisConfigBasic(testDependencyTrack *testutils.TestDependencyTrack, finding analysistestutils.Finding) string {
+	return testDependencyTrack.AddProviderConfiguration(
+		fmt.Sprintf(`
+resource "dependencytrack_analysis" "test" {
+	project_id       = %[1]q
+	component_id     = %[2]q
+	vulnerability_id = %[3]q
+	state            = "IN_TRIAGE"
+}
+`,
+			finding.ProjectID, finding.ComponentID, finding.VulnerabilityID,
+		),
+	)
+}
+
+func testAccAnalysisConfigNotAffected(testDependencyTrack *testutils.TestDependencyTrack, finding analysistestutils.Finding, resetOnDestroy bool) string {
+	return testDependencyTrack.AddProviderConfiguration(
+		fmt.Sprintf(`
+resource "dependencytrack_analysis" "test" {
+	project_id       = %[1]q
+	component_id     = %[2]q
+	vulnerability_id = %[3]q
+	state            = "NOT_AFFECTED"
+	justification    = "CODE_NOT_REACHABLE"
+	response         = "WILL_NOT_FIX"
+	details          = "The vulnerable function is never called"
+	suppressed       = true
+	reset_on_destroy = %[4]t
+}
+`,
+			finding.ProjectID, finding.ComponentID, finding.VulnerabilityID, resetOnDestroy,
+		),
+	)
+}
+
+func testAccAnalysisConfigNoAnalysis(testDependencyTrack *testutils.TestDependencyTrack) string {
+	return testDependencyTrack.AddProviderConfiguration("")
+}
@@ -0,0 +1,101 @@
+// Copyright (c) 2024 Futurice Oy
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	dtrack "github.com/futurice/dependency-track-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// applyAuthenticationEnvFallback fills the authentication attributes from the environment, but only if none of them
+// are set in the configuration. This way credentials for one authentication mode in the environment cannot conflict
+// with a different mode chosen in the configuration.
+func applyAuthenticationEnvFallback(data *DependencyTrackProviderModel) diag.Diagnostics {
+	var diags, fallbackDiags diag.Diagnostics
+
+	if !data.APIKey.IsNull() || !data.BearerToken.IsNull() || !data.Username.IsNull() || !data.Password.IsNull() {
+		return diags
+	}
+
+	data.APIKey, fallbackDiags = stringValueWithEnvFallback(data.APIKey, apiKeyEnvVarName, path.Root("api_key"))
+	diags.Append(fallbackDiags...)
+
+	data.BearerToken, fallbackDiags = stringValueWithEnvFallback(data.BearerToken, bearerTokenEnvVarName, path.Root("bearer_token"))
+	diags.Append(fallbackDiags...)
+
+	data.Username, fallbackDiags = stringValueWithEnvFallback(data.Username, usernameEnvVarName, path.Root("username"))
+	diags.Append(fallbackDiags...)
+
+	data.Password, fallbackDiags = stringValueWithEnvFallback(data.Password, passwordEnvVarName, path.Root("password"))
+	diags.Append(fallbackDiags...)
+
+	return diags
+}
+
+// validateAuthentication checks that exactly one authentication mode is set. The provider config validators already
+// check this for the configuration, but values coming from the environment need to be checked here.
+func validateAuthentication(data DependencyTrackProviderModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	modes := 0
+	for _, value := range []types.String{data.APIKey, data.BearerToken, data.Username} {
+		if !value.IsNull() {
+			modes++
+		}
+	}
+
+	switch {
+	case modes == 0:
+		diags.AddAttributeError(path.Root("api_key"),
+			"Missing Dependency Track API Key",
+			"The provider cannot create the Dependency Track API client as there is a missing or empty value for the Dependency Track API key. "+
+				"Alternatively, set either a bearer token or a username and a password.",
+		)
+	case modes > 1:
+		diags.AddError(
+			"Conflicting Dependency Track Credentials",
+			"Only one of an API key, a bearer token, or a username and a password can be used to authenticate to Dependency Track.",
+		)
+	case !data.Username.IsNull() && data.Password.IsNull():
+		diags.AddAttributeError(path.Root("password"),
+			"Missing Dependency Track Password",
+			"The provider cannot log in to Dependency Track as there is a missing or empty value for the password of the user.",
+		)
+	}
+
+	return diags
+}
+
+// authenticationOption returns the client option for the authentication mode set in data. For username and password,
+// the provider logs in with httpClient to get a bearer token.
+func authenticationOption(ctx context.Context, data DependencyTrackProviderModel, httpClient *http.Client) (dtrack.ClientOption, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	switch {
+	case !data.APIKey.IsNull():
+		return dtrack.WithAPIKey(data.APIKey.ValueString()), diags
+	case !data.BearerToken.IsNull():
+		return dtrack.WithBearerToken(data.BearerToken.ValueString()), diags
+	}
+
+	loginClient, err := dtrack.NewClient(data.Host.ValueString(), dtrack.WithHttpClient(httpClient))
+	if err != nil {
+		diags.AddError("Dependency-Track Client creation failed", err.Error())
+		return nil, diags
+	}
+
+	token, err := loginClient.User.Login(ctx, data.Username.ValueString(), data.Password.ValueString())
+	if err != nil {
+		diags.AddError("Dependency-Track login failed", fmt.Sprintf("Unable to log in as user %s, got error: %s", data.Username.ValueString(), err))
+		return nil, diags
+	}
+
+	return dtrack.WithBearerToken(token), diags
+}
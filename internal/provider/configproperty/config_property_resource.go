@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	dtrack "github.com/futurice/dependency-track-client-go"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/providerdata"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -25,7 +26,7 @@ func NewConfigPropertyResource() resource.Resource {
 
 // ConfigPropertyResource defines the resource implementation.
 type ConfigPropertyResource struct {
-	client *dtrack.Client
+	providerdata.ResourceBase
 }
 
 // ConfigPropertyResourceModel describes the resource data model.
@@ -84,25 +85,6 @@ func (r *ConfigPropertyResource) Schema(ctx context.Context, req resource.Schema
 	}
 }
 
-func (r *ConfigPropertyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if req.ProviderData == nil {
-		return
-	}
-
-	client, ok := req.ProviderData.(*dtrack.Client)
-
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *dtrack.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
-
-		return
-	}
-
-	r.client = client
-}
-
 func (r *ConfigPropertyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan, state ConfigPropertyResourceModel
 
@@ -127,7 +109,7 @@ func (r *ConfigPropertyResource) Create(ctx context.Context, req resource.Create
 		PropertyValue: value,
 	}
 
-	_, err := r.client.Config.SetConfigProperty(ctx, setConfigPropertyRequest)
+	_, err := r.Client.Config.SetConfigProperty(ctx, setConfigPropertyRequest)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set config property, got error: %s", err))
 		return
@@ -199,7 +181,7 @@ func (r *ConfigPropertyResource) Update(ctx context.Context, req resource.Update
 		PropertyValue: value,
 	}
 
-	_, err := r.client.Config.SetConfigProperty(ctx, setConfigPropertyRequest)
+	_, err := r.Client.Config.SetConfigProperty(ctx, setConfigPropertyRequest)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set config property, got error: %s", err))
 		return
@@ -222,14 +204,8 @@ func (r *ConfigPropertyResource) Delete(ctx context.Context, req resource.Delete
 	groupName := state.GroupName.ValueString()
 	name := state.Name.ValueString()
 
-	var restoreValue *string
-	if !state.DestroyValue.IsUnknown() && !state.DestroyValue.IsNull() {
-		destroyValueTmp := state.DestroyValue.ValueString()
-		restoreValue = &destroyValueTmp
-	} else if !state.OriginalValue.IsUnknown() && !state.OriginalValue.IsNull() {
-		originalValueTmp := state.OriginalValue.ValueString()
-		restoreValue = &originalValueTmp
-	} else {
+	restoreValue := findRestoreValue(state.DestroyValue, state.OriginalValue)
+	if restoreValue == nil {
 		resp.Diagnostics.AddWarning("No value to restore", "Neither destroy_value not original_value is available on destroy - the property will not be modified in Dependency-Track")
 	}
 
@@ -240,7 +216,7 @@ func (r *ConfigPropertyResource) Delete(ctx context.Context, req resource.Delete
 			PropertyValue: *restoreValue,
 		}
 
-		_, err := r.client.Config.SetConfigProperty(ctx, setConfigPropertyRequest)
+		_, err := r.Client.Config.SetConfigProperty(ctx, setConfigPropertyRequest)
 		if err != nil {
 			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to reset config property to original value, got error: %s", err))
 			return
@@ -255,21 +231,51 @@ func (r *ConfigPropertyResource) ImportState(ctx context.Context, req resource.I
 }
 
 func (r *ConfigPropertyResource) findConfigProperty(ctx context.Context, groupName, name string) (*dtrack.ConfigProperty, diag.Diagnostics) {
+	configProperties, diags := getAllConfigProperties(ctx, r.Client)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return findConfigProperty(configProperties, groupName, name), diags
+}
+
+func getAllConfigProperties(ctx context.Context, client *dtrack.Client) ([]dtrack.ConfigProperty, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
-	configProperties, err := r.client.Config.GetAllConfigProperties(ctx)
+	configProperties, err := client.Config.GetAllConfigProperties(ctx)
 	if err != nil {
 		diags.AddError("Client Error", fmt.Sprintf("Unable to get config properties, got error: %s", err))
 		return nil, diags
 	}
 
+	return configProperties, diags
+}
+
+// findConfigProperty returns the property with the given group and name, or nil if there is no such property.
+func findConfigProperty(configProperties []dtrack.ConfigProperty, groupName, name string) *dtrack.ConfigProperty {
 	for _, configProperty := range configProperties {
 		if configProperty.GroupName == groupName && configProperty.PropertyName == name {
-			return &configProperty, diags
+			return &configProperty
 		}
 	}
 
-	return nil, diags
+	return nil
+}
+
+// findRestoreValue returns the value to set when the property is no longer managed: the destroy value if it is set,
+// otherwise the original value if it is known, otherwise nil.
+func findRestoreValue(destroyValue, originalValue types.String) *string {
+	if !destroyValue.IsUnknown() && !destroyValue.IsNull() {
+		destroyValueTmp := destroyValue.ValueString()
+		return &destroyValueTmp
+	}
+
+	if !originalValue.IsUnknown() && !originalValue.IsNull() {
+		originalValueTmp := originalValue.ValueString()
+		return &originalValueTmp
+	}
+
+	return nil
 }
 
 func makeConfigPropertyID(groupName string, name string) string {
@@ -0,0 +1,120 @@
+// Copyright (c) 2024 Futurice Oy
+// SPDX-License-Identifier: MPL-2.0
+
+package configproperty
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+const (
+	integrationsGroupName          = "integrations"
+	vulnerabilitySourcesGroupName  = "vuln-source"
+	vulnerabilityAnalyzerGroupName = "scanner"
+)
+
+func NewDefectDojoIntegrationResource() resource.Resource {
+	return newTypedConfigPropertyResource(typedConfigPropertyDefinition{
+		typeName:    "integration_defectdojo",
+		description: "Integration for uploading findings to DefectDojo",
+		properties: []typedProperty{
+			{attribute: "enabled", groupName: integrationsGroupName, name: "defectdojo.enabled", kind: typedPropertyBool, description: "Whether the integration is enabled"},
+			{attribute: "reimport_enabled", groupName: integrationsGroupName, name: "defectdojo.reimport.enabled", kind: typedPropertyBool, description: "Whether findings are reimported to existing tests"},
+			{attribute: "sync_cadence", groupName: integrationsGroupName, name: "defectdojo.sync.cadence", kind: typedPropertyInt64, description: "Synchronization cadence in minutes"},
+			{attribute: "url", groupName: integrationsGroupName, name: "defectdojo.url", kind: typedPropertyString, description: "Base URL of DefectDojo"},
+			{attribute: "api_key", groupName: integrationsGroupName, name: "defectdojo.apiKey", kind: typedPropertyString, description: "API key for DefectDojo", sensitive: true},
+		},
+	})
+}
+
+func NewFortifySSCIntegrationResource() resource.Resource {
+	return newTypedConfigPropertyResource(typedConfigPropertyDefinition{
+		typeName:    "integration_fortify_ssc",
+		description: "Integration for uploading findings to Fortify Software Security Center",
+		properties: []typedProperty{
+			{attribute: "enabled", groupName: integrationsGroupName, name: "fortify.ssc.enabled", kind: typedPropertyBool, description: "Whether the integration is enabled"},
+			{attribute: "sync_cadence", groupName: integrationsGroupName, name: "fortify.ssc.sync.cadence", kind: typedPropertyInt64, description: "Synchronization cadence in minutes"},
+			{attribute: "url", groupName: integrationsGroupName, name: "fortify.ssc.url", kind: typedPropertyString, description: "Base URL of Fortify SSC"},
+			{attribute: "token", groupName: integrationsGroupName, name: "fortify.ssc.token", kind: typedPropertyString, description: "CI token for Fortify SSC", sensitive: true},
+		},
+	})
+}
+
+func NewKennaIntegrationResource() resource.Resource {
+	return newTypedConfigPropertyResource(typedConfigPropertyDefinition{
+		typeName:    "integration_kenna",
+		description: "Integration for uploading findings to Kenna Security",
+		properties: []typedProperty{
+			{attribute: "enabled", groupName: integrationsGroupName, name: "kenna.enabled", kind: typedPropertyBool, description: "Whether the integration is enabled"},
+			{attribute: "sync_cadence", groupName: integrationsGroupName, name: "kenna.sync.cadence", kind: typedPropertyInt64, description: "Synchronization cadence in minutes"},
+			{attribute: "connector_id", groupName: integrationsGroupName, name: "kenna.connector.id", kind: typedPropertyString, description: "ID of the Kenna connector"},
+			{attribute: "token", groupName: integrationsGroupName, name: "kenna.token", kind: typedPropertyString, description: "API token for Kenna", sensitive: true},
+		},
+	})
+}
+
+func NewNVDVulnerabilitySourceResource() resource.Resource {
+	return newTypedConfigPropertyResource(typedConfigPropertyDefinition{
+		typeName:    "vulnerability_source_nvd",
+		description: "Mirroring of the National Vulnerability Database",
+		properties: []typedProperty{
+			{attribute: "enabled", groupName: vulnerabilitySourcesGroupName, name: "nvd.enabled", kind: typedPropertyBool, description: "Whether the NVD is mirrored"},
+			{attribute: "feeds_url", groupName: vulnerabilitySourcesGroupName, name: "nvd.feeds.url", kind: typedPropertyString, description: "URL of the NVD data feeds"},
+			{attribute: "api_enabled", groupName: vulnerabilitySourcesGroupName, name: "nvd.api.enabled", kind: typedPropertyBool, description: "Whether the NVD is mirrored via its API instead of the data feeds"},
+			{attribute: "api_url", groupName: vulnerabilitySourcesGroupName, name: "nvd.api.url", kind: typedPropertyString, description: "URL of the NVD API"},
+			{attribute: "api_key", groupName: vulnerabilitySourcesGroupName, name: "nvd.api.key", kind: typedPropertyString, description: "API key for the NVD API", sensitive: true},
+		},
+	})
+}
+
+func NewGitHubVulnerabilitySourceResource() resource.Resource {
+	return newTypedConfigPropertyResource(typedConfigPropertyDefinition{
+		typeName:    "vulnerability_source_github",
+		description: "Mirroring of the GitHub Advisory Database",
+		properties: []typedProperty{
+			{attribute: "enabled", groupName: vulnerabilitySourcesGroupName, name: "github.advisories.enabled", kind: typedPropertyBool, description: "Whether the GitHub advisories are mirrored"},
+			{attribute: "alias_sync_enabled", groupName: vulnerabilitySourcesGroupName, name: "github.advisories.alias.sync.enabled", kind: typedPropertyBool, description: "Whether the aliases of the advisories are synchronized"},
+			{attribute: "access_token", groupName: vulnerabilitySourcesGroupName, name: "github.advisories.access.token", kind: typedPropertyString, description: "Personal access token for the GitHub API", sensitive: true},
+		},
+	})
+}
+
+func NewOSVVulnerabilitySourceResource() resource.Resource {
+	return newTypedConfigPropertyResource(typedConfigPropertyDefinition{
+		typeName:    "vulnerability_source_osv",
+		description: "Mirroring of the Open Source Vulnerabilities database",
+		properties: []typedProperty{
+			{attribute: "ecosystems", groupName: vulnerabilitySourcesGroupName, name: "google.osv.enabled", kind: typedPropertyStringSet, description: "Ecosystems to mirror, e.g. `Maven` and `npm`. OSV is not mirrored if empty"},
+			{attribute: "alias_sync_enabled", groupName: vulnerabilitySourcesGroupName, name: "google.osv.alias.sync.enabled", kind: typedPropertyBool, description: "Whether the aliases of the vulnerabilities are synchronized"},
+			{attribute: "base_url", groupName: vulnerabilitySourcesGroupName, name: "google.osv.base.url", kind: typedPropertyString, description: "Base URL of the OSV data"},
+		},
+	})
+}
+
+func NewSnykAnalyzerResource() resource.Resource {
+	return newTypedConfigPropertyResource(typedConfigPropertyDefinition{
+		typeName:    "analyzer_snyk",
+		description: "Vulnerability analysis of components using Snyk",
+		properties: []typedProperty{
+			{attribute: "enabled", groupName: vulnerabilityAnalyzerGroupName, name: "snyk.enabled", kind: typedPropertyBool, description: "Whether the analyzer is enabled"},
+			{attribute: "org_id", groupName: vulnerabilityAnalyzerGroupName, name: "snyk.org.id", kind: typedPropertyString, description: "ID of the Snyk organization"},
+			{attribute: "api_token", groupName: vulnerabilityAnalyzerGroupName, name: "snyk.api.token", kind: typedPropertyString, description: "API token for Snyk", sensitive: true},
+			{attribute: "api_version", groupName: vulnerabilityAnalyzerGroupName, name: "snyk.api.version", kind: typedPropertyString, description: "Version of the Snyk API"},
+			{attribute: "base_url", groupName: vulnerabilityAnalyzerGroupName, name: "snyk.base.url", kind: typedPropertyString, description: "Base URL of the Snyk API"},
+			{attribute: "alias_sync_enabled", groupName: vulnerabilityAnalyzerGroupName, name: "snyk.alias.sync.enabled", kind: typedPropertyBool, description: "Whether the aliases of the vulnerabilities are synchronized"},
+		},
+	})
+}
+
+func NewOSSIndexAnalyzerResource() resource.Resource {
+	return newTypedConfigPropertyResource(typedConfigPropertyDefinition{
+		typeName:    "analyzer_oss_index",
+		description: "Vulnerability analysis of components using Sonatype OSS Index",
+		properties: []typedProperty{
+			{attribute: "enabled", groupName: vulnerabilityAnalyzerGroupName, name: "ossindex.enabled", kind: typedPropertyBool, description: "Whether the analyzer is enabled"},
+			{attribute: "username", groupName: vulnerabilityAnalyzerGroupName, name: "ossindex.api.username", kind: typedPropertyString, description: "Username for the OSS Index API"},
+			{attribute: "api_token", groupName: vulnerabilityAnalyzerGroupName, name: "ossindex.api.token", kind: typedPropertyString, description: "API token for the OSS Index API", sensitive: true},
+			{attribute: "alias_sync_enabled", groupName: vulnerabilityAnalyzerGroupName, name: "ossindex.alias.sync.enabled", kind: typedPropertyBool, description: "Whether the aliases of the vulnerabilities are synchronized"},
+		},
+	})
+}
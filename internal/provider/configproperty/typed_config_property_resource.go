@@ -0,0 +1,571 @@
+// Copyright (c) 2024 Futurice Oy
+// SPDX-License-Identifier: MPL-2.0
+
+package configproperty
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	dtrack "github.com/futurice/dependency-track-client-go"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/providerdata"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &TypedConfigPropertyResource{}
+var _ resource.ResourceWithImportState = &TypedConfigPropertyResource{}
+var _ resource.ResourceWithModifyPlan = &TypedConfigPropertyResource{}
+
+// typedPropertyKind is the Terraform type of a typed config property.
+type typedPropertyKind int
+
+const (
+	typedPropertyString typedPropertyKind = iota
+	typedPropertyBool
+	typedPropertyInt64
+	// typedPropertyStringSet is a set of strings, stored in Dependency-Track separated by semicolons
+	typedPropertyStringSet
+)
+
+const typedPropertyStringSetSeparator = ";"
+
+// typedProperty maps a Terraform attribute to a config property.
+type typedProperty struct {
+	attribute   string
+	groupName   string
+	name        string
+	kind        typedPropertyKind
+	description string
+	// sensitive properties are secrets. Dependency-Track does not return their values, so they are neither read for
+	// drift detection nor restored, but cleared when no longer managed.
+	sensitive bool
+}
+
+// plannedProperty is a property with the value it is set to.
+type plannedProperty struct {
+	property typedProperty
+	value    string
+}
+
+// typedConfigPropertyDefinition describes a resource managing several related config properties, e.g. those of an
+// integration.
+type typedConfigPropertyDefinition struct {
+	// typeName is the name of the resource without the provider prefix
+	typeName    string
+	description string
+	properties  []typedProperty
+}
+
+// TypedConfigPropertyResource manages a fixed set of config properties as typed attributes. Like
+// ConfigPropertyResource, it records the original values of the properties and restores them when a property is
+// no longer managed, either because its attribute is removed or the resource is destroyed.
+type TypedConfigPropertyResource struct {
+	providerdata.ResourceBase
+	definition typedConfigPropertyDefinition
+}
+
+func newTypedConfigPropertyResource(definition typedConfigPropertyDefinition) resource.Resource {
+	return &TypedConfigPropertyResource{
+		definition: definition,
+	}
+}
+
+func (r *TypedConfigPropertyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.definition.typeName
+}
+
+func (r *TypedConfigPropertyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		"original_values": schema.MapAttribute{
+			ElementType: types.StringType,
+			MarkdownDescription: "Original values of the managed properties, by attribute name, to be restored when the attributes are removed " +
+				"or the resource is destroyed. Secrets are not included, since Dependency-Track does not return them. They are cleared instead.",
+			Computed: true,
+		},
+		"id": schema.StringAttribute{
+			MarkdownDescription: "Synthetic ID of the resource, which is the resource type name without the provider prefix",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+	}
+
+	for _, property := range r.definition.properties {
+		description := fmt.Sprintf("%s. Sets the config property `%s/%s`.", property.description, property.groupName, property.name)
+
+		switch property.kind {
+		case typedPropertyBool:
+			attributes[property.attribute] = schema.BoolAttribute{
+				MarkdownDescription: description,
+				Optional:            true,
+				Sensitive:           property.sensitive,
+			}
+		case typedPropertyInt64:
+			attributes[property.attribute] = schema.Int64Attribute{
+				MarkdownDescription: description,
+				Optional:            true,
+				Sensitive:           property.sensitive,
+			}
+		case typedPropertyStringSet:
+			attributes[property.attribute] = schema.SetAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: description,
+				Optional:            true,
+				Sensitive:           property.sensitive,
+				Validators: []validator.Set{
+					noSeparatorInElements{},
+				},
+			}
+		default:
+			attributes[property.attribute] = schema.StringAttribute{
+				MarkdownDescription: description,
+				Optional:            true,
+				Sensitive:           property.sensitive,
+			}
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: r.definition.description + ". Only the properties with their attributes set are managed, the others are left as they are.",
+		Attributes:          attributes,
+	}
+}
+
+// ModifyPlan keeps the original values from the state when the same properties are managed as before. When
+// properties are added or removed, the original values change in Update, so they are left unknown.
+func (r *TypedConfigPropertyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	for _, property := range r.definition.properties {
+		planSet, diags := property.isSet(ctx, req.Plan.GetAttribute)
+		resp.Diagnostics.Append(diags...)
+		stateSet, diags := property.isSet(ctx, req.State.GetAttribute)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if planSet != stateSet {
+			return
+		}
+	}
+
+	var originalValues types.Map
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("original_values"), &originalValues)...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("original_values"), originalValues)...)
+}
+
+func (r *TypedConfigPropertyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	configProperties, diags := getAllConfigProperties(ctx, r.Client)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	originalValues := map[string]string{}
+	var planned []plannedProperty
+
+	for _, property := range r.definition.properties {
+		value, diags := property.getValue(ctx, req.Plan.GetAttribute)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if value == nil {
+			continue
+		}
+
+		property.recordOriginalValue(configProperties, originalValues)
+		planned = append(planned, plannedProperty{property: property, value: *value})
+	}
+
+	// The original values are in the state before any property is set, so that they can be restored even if setting
+	// some of the properties fails
+	resp.Diagnostics.Append(r.setOriginalValues(ctx, resp.State.SetAttribute, originalValues)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), r.definition.typeName)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, p := range planned {
+		err := setConfigProperty(ctx, r.Client, p.property.groupName, p.property.name, p.value)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set config property %s, got error: %s", p.property.attribute, err))
+			return
+		}
+
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(p.property.attribute), p.property.terraformValue(ctx, p.value))...)
+	}
+}
+
+func (r *TypedConfigPropertyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	configProperties, diags := getAllConfigProperties(ctx, r.Client)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, property := range r.definition.properties {
+		value, diags := property.getValue(ctx, req.State.GetAttribute)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if value == nil || property.sensitive {
+			continue
+		}
+
+		configProperty := findConfigProperty(configProperties, property.groupName, property.name)
+		if configProperty == nil || configProperty.PropertyValue == nil {
+			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(property.attribute), property.terraformNull())...)
+			continue
+		}
+
+		terraformValue, diags := property.parseValue(ctx, *configProperty.PropertyValue)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(property.attribute), terraformValue)...)
+	}
+}
+
+func (r *TypedConfigPropertyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	configProperties, diags := getAllConfigProperties(ctx, r.Client)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	originalValues, diags := r.getOriginalValues(ctx, req.State.GetAttribute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var planned []plannedProperty
+	var removed []typedProperty
+
+	for _, property := range r.definition.properties {
+		planValue, diags := property.getValue(ctx, req.Plan.GetAttribute)
+		resp.Diagnostics.Append(diags...)
+		stateValue, diags := property.getValue(ctx, req.State.GetAttribute)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		switch {
+		case planValue != nil:
+			if stateValue == nil {
+				// the property was not managed before
+				property.recordOriginalValue(configProperties, originalValues)
+			}
+			planned = append(planned, plannedProperty{property: property, value: *planValue})
+		case stateValue != nil:
+			removed = append(removed, property)
+		}
+	}
+
+	// The original values of newly managed properties are in the state before any property is set, as in Create
+	resp.Diagnostics.Append(r.setOriginalValues(ctx, resp.State.SetAttribute, originalValues)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, p := range planned {
+		err := setConfigProperty(ctx, r.Client, p.property.groupName, p.property.name, p.value)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set config property %s, got error: %s", p.property.attribute, err))
+			return
+		}
+
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(p.property.attribute), p.property.terraformValue(ctx, p.value))...)
+	}
+
+	for _, property := range removed {
+		resp.Diagnostics.Append(r.restoreProperty(ctx, property, originalValues)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		delete(originalValues, property.attribute)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(property.attribute), property.terraformNull())...)
+		resp.Diagnostics.Append(r.setOriginalValues(ctx, resp.State.SetAttribute, originalValues)...)
+	}
+}
+
+func (r *TypedConfigPropertyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	originalValues, diags := r.getOriginalValues(ctx, req.State.GetAttribute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, property := range r.definition.properties {
+		value, diags := property.getValue(ctx, req.State.GetAttribute)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if value == nil {
+			continue
+		}
+
+		resp.Diagnostics.Append(r.restoreProperty(ctx, property, originalValues)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *TypedConfigPropertyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.AddError("Not supported", "Importing this resource is not necessary. Instead just create a resource to set the properties to what you want them to be")
+}
+
+// restoreProperty sets the property back to its original value. Secrets are cleared instead.
+func (r *TypedConfigPropertyResource) restoreProperty(ctx context.Context, property typedProperty, originalValues map[string]string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	originalValue := types.StringNull()
+	if value, ok := originalValues[property.attribute]; ok {
+		originalValue = types.StringValue(value)
+	}
+
+	restoreValue := findRestoreValue(types.StringNull(), originalValue)
+	if restoreValue == nil {
+		if !property.sensitive {
+			diags.AddWarning("No value to restore", fmt.Sprintf("The original value of %s is not available - the property will not be modified in Dependency-Track", property.attribute))
+			return diags
+		}
+
+		emptyValue := ""
+		restoreValue = &emptyValue
+	}
+
+	err := setConfigProperty(ctx, r.Client, property.groupName, property.name, *restoreValue)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to reset config property %s to original value, got error: %s", property.attribute, err))
+	}
+
+	return diags
+}
+
+func (r *TypedConfigPropertyResource) getOriginalValues(ctx context.Context, getAttribute attributeGetter) (map[string]string, diag.Diagnostics) {
+	var originalValuesMap types.Map
+	diags := getAttribute(ctx, path.Root("original_values"), &originalValuesMap)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	originalValues := map[string]string{}
+	if !originalValuesMap.IsNull() && !originalValuesMap.IsUnknown() {
+		diags.Append(originalValuesMap.ElementsAs(ctx, &originalValues, false)...)
+	}
+
+	return originalValues, diags
+}
+
+func (r *TypedConfigPropertyResource) setOriginalValues(ctx context.Context, setAttribute attributeSetter, originalValues map[string]string) diag.Diagnostics {
+	originalValuesMap, diags := types.MapValueFrom(ctx, types.StringType, originalValues)
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(setAttribute(ctx, path.Root("original_values"), originalValuesMap)...)
+	return diags
+}
+
+// attributeGetter is the GetAttribute method of a plan or state.
+type attributeGetter func(ctx context.Context, path path.Path, target interface{}) diag.Diagnostics
+
+// attributeSetter is the SetAttribute method of a state.
+type attributeSetter func(ctx context.Context, path path.Path, val interface{}) diag.Diagnostics
+
+// isSet returns whether the attribute of the property is set, i.e. not null. Unknown values are set.
+func (p typedProperty) isSet(ctx context.Context, getAttribute attributeGetter) (bool, diag.Diagnostics) {
+	var value attr.Value
+	diags := getAttribute(ctx, path.Root(p.attribute), &value)
+	if diags.HasError() {
+		return false, diags
+	}
+
+	return !value.IsNull(), diags
+}
+
+// getValue returns the value of the attribute of the property as a config property value, or nil if it is not set.
+func (p typedProperty) getValue(ctx context.Context, getAttribute attributeGetter) (*string, diag.Diagnostics) {
+	var value string
+	attributePath := path.Root(p.attribute)
+
+	switch p.kind {
+	case typedPropertyBool:
+		var boolValue types.Bool
+		diags := getAttribute(ctx, attributePath, &boolValue)
+		if diags.HasError() || boolValue.IsNull() || boolValue.IsUnknown() {
+			return nil, diags
+		}
+		value = strconv.FormatBool(boolValue.ValueBool())
+	case typedPropertyInt64:
+		var int64Value types.Int64
+		diags := getAttribute(ctx, attributePath, &int64Value)
+		if diags.HasError() || int64Value.IsNull() || int64Value.IsUnknown() {
+			return nil, diags
+		}
+		value = strconv.FormatInt(int64Value.ValueInt64(), 10)
+	case typedPropertyStringSet:
+		var setValue types.Set
+		diags := getAttribute(ctx, attributePath, &setValue)
+		if diags.HasError() || setValue.IsNull() || setValue.IsUnknown() {
+			return nil, diags
+		}
+
+		var elements []string
+		diags.Append(setValue.ElementsAs(ctx, &elements, false)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		sort.Strings(elements)
+		value = strings.Join(elements, typedPropertyStringSetSeparator)
+	default:
+		var stringValue types.String
+		diags := getAttribute(ctx, attributePath, &stringValue)
+		if diags.HasError() || stringValue.IsNull() || stringValue.IsUnknown() {
+			return nil, diags
+		}
+		value = stringValue.ValueString()
+	}
+
+	return &value, nil
+}
+
+// parseValue converts a config property value returned by the server to the Terraform value of the attribute.
+func (p typedProperty) parseValue(ctx context.Context, value string) (interface{}, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	switch p.kind {
+	case typedPropertyBool:
+		boolValue, err := strconv.ParseBool(value)
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to parse config property %s as a boolean, got error: %s", p.attribute, err))
+			return nil, diags
+		}
+		return types.BoolValue(boolValue), diags
+	case typedPropertyInt64:
+		int64Value, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to parse config property %s as a number, got error: %s", p.attribute, err))
+			return nil, diags
+		}
+		return types.Int64Value(int64Value), diags
+	default:
+		return p.terraformValue(ctx, value), diags
+	}
+}
+
+// terraformValue converts a config property value set by this resource to the Terraform value of the attribute.
+func (p typedProperty) terraformValue(ctx context.Context, value string) interface{} {
+	switch p.kind {
+	case typedPropertyBool:
+		boolValue, _ := strconv.ParseBool(value)
+		return types.BoolValue(boolValue)
+	case typedPropertyInt64:
+		int64Value, _ := strconv.ParseInt(value, 10, 64)
+		return types.Int64Value(int64Value)
+	case typedPropertyStringSet:
+		elements := []string{}
+		for _, element := range strings.Split(value, typedPropertyStringSetSeparator) {
+			if element != "" {
+				elements = append(elements, element)
+			}
+		}
+		setValue, _ := types.SetValueFrom(ctx, types.StringType, elements)
+		return setValue
+	default:
+		return types.StringValue(value)
+	}
+}
+
+func (p typedProperty) terraformNull() interface{} {
+	switch p.kind {
+	case typedPropertyBool:
+		return types.BoolNull()
+	case typedPropertyInt64:
+		return types.Int64Null()
+	case typedPropertyStringSet:
+		return types.SetNull(types.StringType)
+	default:
+		return types.StringNull()
+	}
+}
+
+// recordOriginalValue adds the current value of the property to the original values, unless it is a secret.
+func (p typedProperty) recordOriginalValue(configProperties []dtrack.ConfigProperty, originalValues map[string]string) {
+	if p.sensitive {
+		return
+	}
+
+	configProperty := findConfigProperty(configProperties, p.groupName, p.name)
+	if configProperty != nil && configProperty.PropertyValue != nil {
+		originalValues[p.attribute] = *configProperty.PropertyValue
+	}
+}
+
+func setConfigProperty(ctx context.Context, client *dtrack.Client, groupName, name, value string) error {
+	setConfigPropertyRequest := dtrack.SetConfigPropertyRequest{
+		GroupName:     groupName,
+		PropertyName:  name,
+		PropertyValue: value,
+	}
+
+	_, err := client.Config.SetConfigProperty(ctx, setConfigPropertyRequest)
+	return err
+}
+
+// noSeparatorInElements validates that the elements of a string set do not contain the separator used for storing
+// the set in a single config property.
+type noSeparatorInElements struct{}
+
+func (v noSeparatorInElements) Description(ctx context.Context) string {
+	return fmt.Sprintf("elements must not contain %q", typedPropertyStringSetSeparator)
+}
+
+func (v noSeparatorInElements) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v noSeparatorInElements) ValidateSet(ctx context.Context, req validator.SetRequest, resp *validator.SetResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	for _, element := range req.ConfigValue.Elements() {
+		stringElement, ok := element.(types.String)
+		if !ok || stringElement.IsUnknown() || stringElement.IsNull() {
+			continue
+		}
+
+		if strings.Contains(stringElement.ValueString(), typedPropertyStringSetSeparator) {
+			resp.Diagnostics.AddAttributeError(req.Path, "Invalid Set Element",
+				fmt.Sprintf("The element %q contains %q, which is used for separating the elements in Dependency-Track", stringElement.ValueString(), typedPropertyStringSetSeparator))
+		}
+	}
+}
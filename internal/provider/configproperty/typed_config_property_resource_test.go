@@ -0,0 +1,191 @@
+// Copyright (c) 2024 Futurice Oy
+// SPDX-License-Identifier: MPL-2.0
+
+package configproperty_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/futurice/terraform-provider-dependencytrack/internal/testutils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDefectDojoIntegrationResource_basic(t *testing.T) {
+	ctx := testutils.CreateTestContext(t)
+
+	groupName := "integrations"
+	originalEnabled := "false"
+	originalSyncCadence := "60"
+	originalURL := "https://original.example.com"
+
+	defectDojoResourceName := "dependencytrack_integration_defectdojo.test"
+
+	// fix the "original" values before the test
+	for name, value := range map[string]string{
+		"defectdojo.enabled":      originalEnabled,
+		"defectdojo.sync.cadence": originalSyncCadence,
+		"defectdojo.url":          originalURL,
+	} {
+		err := setConfigProperty(ctx, testDependencyTrack, groupName, name, value)
+		if err != nil {
+			t.Fatalf("Failed to set original value before the test: %v", err)
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDefectDojoIntegrationConfigBasic(testDependencyTrack),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckConfigPropertyHasExpectedValue(ctx, testDependencyTrack, groupName, "defectdojo.enabled", "true"),
+					testAccCheckConfigPropertyHasExpectedValue(ctx, testDependencyTrack, groupName, "defectdojo.sync.cadence", "30"),
+					testAccCheckConfigPropertyHasExpectedValue(ctx, testDependencyTrack, groupName, "defectdojo.url", "https://defectdojo.example.com"),
+					resource.TestCheckResourceAttr(defectDojoResourceName, "id", "integration_defectdojo"),
+					resource.TestCheckResourceAttr(defectDojoResourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(defectDojoResourceName, "sync_cadence", "30"),
+					resource.TestCheckResourceAttr(defectDojoResourceName, "url", "https://defectdojo.example.com"),
+					resource.TestCheckResourceAttr(defectDojoResourceName, "api_key", "secret"),
+					resource.TestCheckNoResourceAttr(defectDojoResourceName, "reimport_enabled"),
+					resource.TestCheckResourceAttr(defectDojoResourceName, "original_values.%", "3"),
+					resource.TestCheckResourceAttr(defectDojoResourceName, "original_values.enabled", originalEnabled),
+					resource.TestCheckResourceAttr(defectDojoResourceName, "original_values.sync_cadence", originalSyncCadence),
+					resource.TestCheckResourceAttr(defectDojoResourceName, "original_values.url", originalURL),
+				),
+			},
+			{
+				Config: testAccDefectDojoIntegrationConfigNoURL(testDependencyTrack),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckConfigPropertyHasExpectedValue(ctx, testDependencyTrack, groupName, "defectdojo.enabled", "true"),
+					testAccCheckConfigPropertyHasExpectedValue(ctx, testDependencyTrack, groupName, "defectdojo.sync.cadence", "15"),
+					testAccCheckConfigPropertyHasExpectedValue(ctx, testDependencyTrack, groupName, "defectdojo.url", originalURL),
+					resource.TestCheckResourceAttr(defectDojoResourceName, "sync_cadence", "15"),
+					resource.TestCheckNoResourceAttr(defectDojoResourceName, "url"),
+					resource.TestCheckNoResourceAttr(defectDojoResourceName, "api_key"),
+					resource.TestCheckResourceAttr(defectDojoResourceName, "original_values.%", "2"),
+					resource.TestCheckNoResourceAttr(defectDojoResourceName, "original_values.url"),
+				),
+			},
+			{
+				Config: testAccDefectDojoIntegrationConfigURLWithoutCadence(testDependencyTrack),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckConfigPropertyHasExpectedValue(ctx, testDependencyTrack, groupName, "defectdojo.sync.cadence", originalSyncCadence),
+					testAccCheckConfigPropertyHasExpectedValue(ctx, testDependencyTrack, groupName, "defectdojo.url", "https://defectdojo.example.com"),
+					resource.TestCheckNoResourceAttr(defectDojoResourceName, "sync_cadence"),
+					resource.TestCheckResourceAttr(defectDojoResourceName, "url", "https://defectdojo.example.com"),
+					resource.TestCheckResourceAttr(defectDojoResourceName, "original_values.%", "2"),
+					resource.TestCheckResourceAttr(defectDojoResourceName, "original_values.enabled", originalEnabled),
+					resource.TestCheckResourceAttr(defectDojoResourceName, "original_values.url", originalURL),
+				),
+			},
+			{
+				Config:   testAccDefectDojoIntegrationConfigURLWithoutCadence(testDependencyTrack),
+				PlanOnly: true,
+			},
+			{
+				ResourceName:  defectDojoResourceName,
+				ImportState:   true,
+				ImportStateId: "integration_defectdojo",
+				ExpectError:   regexp.MustCompile("Not supported"),
+			},
+		},
+		CheckDestroy: resource.ComposeAggregateTestCheckFunc(
+			testAccCheckConfigPropertyHasExpectedValue(ctx, testDependencyTrack, groupName, "defectdojo.enabled", originalEnabled),
+			testAccCheckConfigPropertyHasExpectedValue(ctx, testDependencyTrack, groupName, "defectdojo.sync.cadence", originalSyncCadence),
+			testAccCheckConfigPropertyHasExpectedValue(ctx, testDependencyTrack, groupName, "defectdojo.url", originalURL),
+		),
+	})
+}
+
+func TestAccOSVVulnerabilitySourceResource_ecosystems(t *testing.T) {
+	ctx := testutils.CreateTestContext(t)
+
+	groupName := "vuln-source"
+	name := "google.osv.enabled"
+	originalValue := "PyPI"
+
+	osvResourceName := "dependencytrack_vulnerability_source_osv.test"
+
+	// fix the "original" value before the test
+	err := setConfigProperty(ctx, testDependencyTrack, groupName, name, originalValue)
+	if err != nil {
+		t.Fatalf("Failed to set original value before the test: %v", err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOSVVulnerabilitySourceConfigEcosystems(testDependencyTrack, `["npm", "Maven"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckConfigPropertyHasExpectedValue(ctx, testDependencyTrack, groupName, name, "Maven;npm"),
+					resource.TestCheckResourceAttr(osvResourceName, "ecosystems.#", "2"),
+					resource.TestCheckTypeSetElemAttr(osvResourceName, "ecosystems.*", "Maven"),
+					resource.TestCheckTypeSetElemAttr(osvResourceName, "ecosystems.*", "npm"),
+					resource.TestCheckResourceAttr(osvResourceName, "original_values.ecosystems", originalValue),
+				),
+			},
+			{
+				Config: testAccOSVVulnerabilitySourceConfigEcosystems(testDependencyTrack, `["Go"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckConfigPropertyHasExpectedValue(ctx, testDependencyTrack, groupName, name, "Go"),
+					resource.TestCheckResourceAttr(osvResourceName, "ecosystems.#", "1"),
+					resource.TestCheckResourceAttr(osvResourceName, "original_values.ecosystems", originalValue),
+				),
+			},
+			{
+				Config:      testAccOSVVulnerabilitySourceConfigEcosystems(testDependencyTrack, `["Go;npm"]`),
+				ExpectError: regexp.MustCompile("Invalid Set Element"),
+			},
+		},
+		CheckDestroy: testAccCheckConfigPropertyHasExpectedValue(ctx, testDependencyTrack, groupName, name, originalValue),
+	})
+}
+
+func testAccDefectDojoIntegrationConfigBasic(testDependencyTrack *testutils.TestDependencyTrack) string {
+	return testDependencyTrack.AddProviderConfiguration(`
+resource "dependencytrack_integration_defectdojo" "test" {
+	enabled      = true
+	sync_cadence = 30
+	url          = "https://defectdojo.example.com"
+	api_key      = "secret"
+}
+`,
+	)
+}
+
+func testAccDefectDojoIntegrationConfigNoURL(testDependencyTrack *testutils.TestDependencyTrack) string {
+	return testDependencyTrack.AddProviderConfiguration(`
+resource "dependencytrack_integration_defectdojo" "test" {
+	enabled      = true
+	sync_cadence = 15
+}
+`,
+	)
+}
+
+func testAccDefectDojoIntegrationConfigURLWithoutCadence(testDependencyTrack *testutils.TestDependencyTrack) string {
+	return testDependencyTrack.AddProviderConfiguration(`
+resource "dependencytrack_integration_defectdojo" "test" {
+	enabled = true
+	url     = "https://defectdojo.example.com"
+}
+`,
+	)
+}
+
+func testAccOSVVulnerabilitySourceConfigEcosystems(testDependencyTrack *testutils.TestDependencyTrack, ecosystems string) string {
+	return testDependencyTrack.AddProviderConfiguration(
+		fmt.Sprintf(`
+resource "dependencytrack_vulnerability_source_osv" "test" {
+	ecosystems = %[1]s
+}
+`,
+			ecosystems,
+		),
+	)
+}
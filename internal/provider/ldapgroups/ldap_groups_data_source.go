@@ -0,0 +1,86 @@
+// Copyright (c) 2024 Futurice Oy
+// SPDX-License-Identifier: MPL-2.0
+
+package ldapgroups
+
+import (
+	"context"
+	"fmt"
+
+	dtrack "github.com/futurice/dependency-track-client-go"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/providerdata"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &LDAPGroupsDataSource{}
+
+func NewLDAPGroupsDataSource() datasource.DataSource {
+	return &LDAPGroupsDataSource{}
+}
+
+// LDAPGroupsDataSource defines the data source implementation.
+type LDAPGroupsDataSource struct {
+	providerdata.DataSourceBase
+}
+
+// LDAPGroupsDataSourceModel describes the data source data model.
+type LDAPGroupsDataSourceModel struct {
+	SearchText types.String `tfsdk:"search_text"`
+	Groups     types.List   `tfsdk:"groups"`
+}
+
+func (d *LDAPGroupsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ldap_groups"
+}
+
+func (d *LDAPGroupsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Searches the groups of the LDAP directory Dependency-Track is configured with. " +
+			"The search is done by Dependency-Track, so LDAP must be enabled on the server.",
+
+		Attributes: map[string]schema.Attribute{
+			"search_text": schema.StringAttribute{
+				MarkdownDescription: "Text to search for in the group names. All groups are returned if not set.",
+				Optional:            true,
+			},
+			"groups": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Distinguished names of the matching groups",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *LDAPGroupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var model LDAPGroupsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groups, err := dtrack.FetchAll(func(po dtrack.PageOptions) (dtrack.Page[string], error) {
+		return d.Client.LDAP.GetGroups(ctx, model.SearchText.ValueString(), po)
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to search LDAP groups, got error: %s", err))
+		return
+	}
+
+	if groups == nil {
+		groups = []string{}
+	}
+
+	groupsValue, groupsDiags := types.ListValueFrom(ctx, types.StringType, groups)
+	resp.Diagnostics.Append(groupsDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	model.Groups = groupsValue
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
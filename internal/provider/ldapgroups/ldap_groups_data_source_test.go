@@ -0,0 +1,191 @@
+// Copyright (c) 2024 Futurice Oy
+// SPDX-License-Identifier: MPL-2.0
+
+package ldapgroups_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	dtrack "github.com/futurice/dependency-track-client-go"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/provider/ldapgroups"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/providerdata"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// testGroups are the groups of the fake LDAP directory, see testLDAPHandler.
+var testGroups = []string{
+	"CN=Developers,OU=Groups,DC=example,DC=com",
+	"CN=Security,OU=Groups,DC=example,DC=com",
+	"CN=Security Champions,OU=Groups,DC=example,DC=com",
+}
+
+func TestLDAPGroupsDataSource_all(t *testing.T) {
+	groups, diags := readTestLDAPGroups(t, testLDAPHandler(t), nil)
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+
+	if diff := cmp.Diff(testGroups, groups); diff != "" {
+		t.Errorf("Unexpected groups: %s", diff)
+	}
+}
+
+func TestLDAPGroupsDataSource_searchText(t *testing.T) {
+	searchText := "security"
+
+	groups, diags := readTestLDAPGroups(t, testLDAPHandler(t), &searchText)
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+
+	if diff := cmp.Diff(testGroups[1:], groups); diff != "" {
+		t.Errorf("Unexpected groups: %s", diff)
+	}
+}
+
+func TestLDAPGroupsDataSource_noMatches(t *testing.T) {
+	searchText := "nonexistent"
+
+	groups, diags := readTestLDAPGroups(t, testLDAPHandler(t), &searchText)
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+
+	if groups == nil || len(groups) != 0 {
+		t.Errorf("Expected an empty list of groups, got %v", groups)
+	}
+}
+
+func TestLDAPGroupsDataSource_serverError(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	_, diags := readTestLDAPGroups(t, handler, nil)
+	if !diags.HasError() {
+		t.Fatalf("Error expected, but received none")
+	}
+
+	if diags[0].Summary() != "Client Error" {
+		t.Errorf("Unexpected error: %v", diags)
+	}
+}
+
+// testLDAPHandler serves the LDAP group search of Dependency-Track, matching testGroups case-insensitively like
+// the LDAP server would.
+func testLDAPHandler(t *testing.T) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/api/v1/ldap/groups" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		searchText := strings.ToLower(r.URL.Query().Get("searchText"))
+		matches := []string{}
+		for _, group := range testGroups {
+			if strings.Contains(strings.ToLower(group), searchText) {
+				matches = append(matches, group)
+			}
+		}
+
+		pageNumber, err := strconv.Atoi(r.URL.Query().Get("pageNumber"))
+		if err != nil || pageNumber < 1 {
+			pageNumber = 1
+		}
+		pageSize, err := strconv.Atoi(r.URL.Query().Get("pageSize"))
+		if err != nil || pageSize < 1 {
+			pageSize = len(matches)
+		}
+
+		start := min((pageNumber-1)*pageSize, len(matches))
+		end := min(start+pageSize, len(matches))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Total-Count", strconv.Itoa(len(matches)))
+		if err := json.NewEncoder(w).Encode(matches[start:end]); err != nil {
+			t.Errorf("Failed to write response: %v", err)
+		}
+	})
+}
+
+// readTestLDAPGroups reads the data source against a fake Dependency-Track API server serving handler.
+func readTestLDAPGroups(t *testing.T, handler http.Handler, searchText *string) ([]string, diag.Diagnostics) {
+	t.Helper()
+
+	ctx := context.Background()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	client, err := dtrack.NewClient(server.URL)
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	d := ldapgroups.NewLDAPGroupsDataSource()
+
+	configureResp := datasource.ConfigureResponse{}
+	d.(datasource.DataSourceWithConfigure).Configure(ctx, datasource.ConfigureRequest{
+		ProviderData: &providerdata.ProviderData{Client: client},
+	}, &configureResp)
+	if configureResp.Diagnostics.HasError() {
+		t.Fatalf("Unexpected configure error: %v", configureResp.Diagnostics)
+	}
+
+	schemaResp := datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+
+	objectType, ok := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	if !ok {
+		t.Fatalf("Data source schema is not an object")
+	}
+
+	var searchTextValue tftypes.Value
+	if searchText != nil {
+		searchTextValue = tftypes.NewValue(tftypes.String, *searchText)
+	} else {
+		searchTextValue = tftypes.NewValue(tftypes.String, nil)
+	}
+
+	req := datasource.ReadRequest{
+		Config: tfsdk.Config{
+			Schema: schemaResp.Schema,
+			Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+				"search_text": searchTextValue,
+				"groups":      tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
+			}),
+		},
+	}
+	resp := datasource.ReadResponse{
+		State: tfsdk.State{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(objectType, nil),
+		},
+	}
+
+	d.Read(ctx, req, &resp)
+	if resp.Diagnostics.HasError() {
+		return nil, resp.Diagnostics
+	}
+
+	var model ldapgroups.LDAPGroupsDataSourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return nil, resp.Diagnostics
+	}
+
+	groups := []string{}
+	resp.Diagnostics.Append(model.Groups.ElementsAs(ctx, &groups, false)...)
+
+	return groups, resp.Diagnostics
+}
@@ -0,0 +1,219 @@
+// Copyright (c) 2024 Futurice Oy
+// SPDX-License-Identifier: MPL-2.0
+
+package ldapmapping
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	dtrack "github.com/futurice/dependency-track-client-go"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/providerdata"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/utils"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &LDAPMappingResource{}
+var _ resource.ResourceWithImportState = &LDAPMappingResource{}
+
+func NewLDAPMappingResource() resource.Resource {
+	return &LDAPMappingResource{}
+}
+
+// LDAPMappingResource defines the resource implementation.
+type LDAPMappingResource struct {
+	providerdata.ResourceBase
+}
+
+// LDAPMappingResourceModel describes the resource data model.
+type LDAPMappingResourceModel struct {
+	ID     types.String `tfsdk:"id"`
+	TeamID types.String `tfsdk:"team_id"`
+	DN     types.String `tfsdk:"dn"`
+}
+
+func (r *LDAPMappingResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ldap_mapping"
+}
+
+func (r *LDAPMappingResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Mapping of an LDAP group to a team. LDAP users in the group become members of the team when " +
+			"they log in or are synchronized.",
+
+		Attributes: map[string]schema.Attribute{
+			"team_id": schema.StringAttribute{
+				MarkdownDescription: "Team UUID",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"dn": schema.StringAttribute{
+				MarkdownDescription: "Distinguished name of the LDAP group, e.g. one returned by the `dependencytrack_ldap_groups` data source",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Synthetic LDAP mapping ID in the form of team_id/dn",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *LDAPMappingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan LDAPMappingResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	teamID, teamIDDiags := utils.ParseAttributeUUID(plan.TeamID.ValueString(), "team_id")
+	resp.Diagnostics.Append(teamIDDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.Client.LDAP.AddMapping(ctx, dtrack.LDAPMappingRequest{
+		Team: teamID,
+		DN:   plan.DN.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create LDAP mapping, got error: %s", err))
+		return
+	}
+
+	plan.ID = types.StringValue(makeLDAPMappingID(teamID, plan.DN.ValueString()))
+	plan.TeamID = types.StringValue(teamID.String())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *LDAPMappingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state LDAPMappingResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	teamID, teamIDDiags := utils.ParseAttributeUUID(state.TeamID.ValueString(), "team_id")
+	resp.Diagnostics.Append(teamIDDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	mapping, err := r.findMapping(ctx, teamID, state.DN.ValueString())
+	if err != nil {
+		var apiErr *dtrack.APIError
+		if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read LDAP mapping, got error: %s", err))
+		return
+	}
+	if mapping == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.ID = types.StringValue(makeLDAPMappingID(teamID, mapping.DN))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *LDAPMappingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.AddError("Internal Error", "LDAP mapping resource is immutable")
+}
+
+func (r *LDAPMappingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state LDAPMappingResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	teamID, teamIDDiags := utils.ParseAttributeUUID(state.TeamID.ValueString(), "team_id")
+	resp.Diagnostics.Append(teamIDDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The mapping is deleted by its UUID, which is not part of the state
+	mapping, err := r.findMapping(ctx, teamID, state.DN.ValueString())
+	if err != nil {
+		var apiErr *dtrack.APIError
+		if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
+			return
+		}
+
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read LDAP mapping, got error: %s", err))
+		return
+	}
+	if mapping == nil {
+		return
+	}
+
+	err = r.Client.LDAP.RemoveMapping(ctx, mapping.UUID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete LDAP mapping, got error: %s", err))
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *LDAPMappingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// The DN may contain slashes, but the team UUID does not
+	parts := strings.SplitN(req.ID, "/", 2)
+	if len(parts) != 2 || parts[1] == "" {
+		resp.Diagnostics.AddError("Invalid import ID", fmt.Sprintf("Expected ID in the format 'team_id/dn', got [%s]", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("dn"), parts[1])...)
+}
+
+// findMapping returns the mapping of the team to the DN, or nil if the team has no such mapping.
+func (r *LDAPMappingResource) findMapping(ctx context.Context, teamID uuid.UUID, dn string) (*dtrack.LDAPMapping, error) {
+	mappings, err := r.Client.LDAP.GetTeamMappings(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, mapping := range mappings {
+		if mapping.DN == dn {
+			return &mapping, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func makeLDAPMappingID(teamID uuid.UUID, dn string) string {
+	return fmt.Sprintf("%s/%s", teamID.String(), dn)
+}
@@ -0,0 +1,147 @@
+// Copyright (c) 2024 Futurice Oy
+// SPDX-License-Identifier: MPL-2.0
+
+package ldapmapping_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/futurice/terraform-provider-dependencytrack/internal/testutils"
+	ldaptestutils "github.com/futurice/terraform-provider-dependencytrack/internal/testutils/ldap"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/testutils/teamtestutils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+var testDependencyTrack *testutils.TestDependencyTrack
+
+func TestMain(m *testing.M) {
+	if os.Getenv(resource.EnvTfAcc) != "" {
+		var cleanup func()
+		testDependencyTrack, cleanup = testutils.InitTestDependencyTrack()
+		defer cleanup()
+	}
+
+	m.Run()
+}
+
+func TestAccLDAPMappingResource_basic(t *testing.T) {
+	ctx := testutils.CreateTestContext(t)
+
+	teamName := acctest.RandomWithPrefix("test-team")
+	dn := "CN=Developers,OU=Groups/Teams,DC=example,DC=com"
+	otherDN := "CN=Security,OU=Groups,DC=example,DC=com"
+
+	teamResourceName := teamtestutils.CreateTeamResourceName("test")
+	mappingResourceName := ldaptestutils.CreateLDAPMappingResourceName("test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLDAPMappingConfigBasic(testDependencyTrack, teamName, dn),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					ldaptestutils.TestAccCheckLDAPMappingExists(ctx, testDependencyTrack, teamResourceName, dn),
+					resource.TestCheckResourceAttrPair(mappingResourceName, "team_id", teamResourceName, "id"),
+					resource.TestCheckResourceAttr(mappingResourceName, "dn", dn),
+				),
+			},
+			{
+				ResourceName:      mappingResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccLDAPMappingConfigBasic(testDependencyTrack, teamName, otherDN),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					ldaptestutils.TestAccCheckLDAPMappingExists(ctx, testDependencyTrack, teamResourceName, otherDN),
+					ldaptestutils.TestAccCheckLDAPMappingDoesNotExists(ctx, testDependencyTrack, teamResourceName, dn),
+					resource.TestCheckResourceAttr(mappingResourceName, "dn", otherDN),
+				),
+			},
+			{
+				Config: testAccLDAPMappingConfigNoMapping(testDependencyTrack, teamName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					ldaptestutils.TestAccCheckLDAPMappingDoesNotExists(ctx, testDependencyTrack, teamResourceName, otherDN),
+				),
+			},
+		},
+		// CheckDestroy is not practical here since the team is destroyed as well, and we can no longer query its mappings
+	})
+}
+
+func TestAccLDAPMappingResource_disappears(t *testing.T) {
+	ctx := testutils.CreateTestContext(t)
+
+	teamName := acctest.RandomWithPrefix("test-team")
+	dn := "CN=Developers,OU=Groups,DC=example,DC=com"
+
+	teamResourceName := teamtestutils.CreateTeamResourceName("test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLDAPMappingConfigBasic(testDependencyTrack, teamName, dn),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccLDAPMappingDeleteOutsideTerraform(ctx, teamResourceName, dn),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccLDAPMappingDeleteOutsideTerraform(ctx context.Context, teamResourceName, dn string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		mapping, err := ldaptestutils.FindLDAPMappingByTeamResourceName(ctx, testDependencyTrack, state, teamResourceName, dn)
+		if err != nil {
+			return err
+		}
+		if mapping == nil {
+			return fmt.Errorf("LDAP group %s is not mapped to team %s", dn, teamResourceName)
+		}
+
+		err = testDependencyTrack.Client.LDAP.RemoveMapping(ctx, mapping.UUID)
+		if err != nil {
+			return fmt.Errorf("failed to remove LDAP mapping %s: %w", mapping.UUID, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccLDAPMappingConfigBasic(testDependencyTrack *testutils.TestDependencyTrack, teamName, dn string) string {
+	return testDependencyTrack.AddProviderConfiguration(
+		fmt.Sprintf(`
+resource "dependencytrack_team" "test" {
+	name = %[1]q
+}
+
+resource "dependencytrack_ldap_mapping" "test" {
+	team_id = dependencytrack_team.test.id
+	dn      = %[2]q
+}
+`,
+			teamName, dn,
+		),
+	)
+}
+
+func testAccLDAPMappingConfigNoMapping(testDependencyTrack *testutils.TestDependencyTrack, teamName string) string {
+	return testDependencyTrack.AddProviderConfiguration(
+		fmt.Sprintf(`
+resource "dependencytrack_team" "test" {
+	name = %[1]q
+}
+`,
+			teamName,
+		),
+	)
+}
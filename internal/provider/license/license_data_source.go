@@ -0,0 +1,116 @@
+// Copyright (c) 2024 Futurice Oy
+// SPDX-License-Identifier: MPL-2.0
+
+package license
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/futurice/terraform-provider-dependencytrack/internal/providerdata"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &LicenseDataSource{}
+
+func NewLicenseDataSource() datasource.DataSource {
+	return &LicenseDataSource{}
+}
+
+// LicenseDataSource defines the data source implementation.
+type LicenseDataSource struct {
+	providerdata.DataSourceBase
+}
+
+// LicenseDataSourceModel describes the data source data model.
+type LicenseDataSourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	LicenseID   types.String `tfsdk:"license_id"`
+	Name        types.String `tfsdk:"name"`
+	OSIApproved types.Bool   `tfsdk:"osi_approved"`
+	FSFLibre    types.Bool   `tfsdk:"fsf_libre"`
+	Deprecated  types.Bool   `tfsdk:"deprecated"`
+	Custom      types.Bool   `tfsdk:"custom"`
+}
+
+func (d *LicenseDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_license"
+}
+
+func (d *LicenseDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "License data source. Looks up a license, usually one of the SPDX licenses, by its license ID.",
+
+		Attributes: map[string]schema.Attribute{
+			"license_id": schema.StringAttribute{
+				MarkdownDescription: "SPDX license ID of the license, e.g. `Apache-2.0`",
+				Required:            true,
+				Validators: []validator.String{
+					validators.SPDXLicenseID(),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "License UUID",
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the license",
+				Computed:            true,
+			},
+			"osi_approved": schema.BoolAttribute{
+				MarkdownDescription: "Whether the license is approved by the Open Source Initiative",
+				Computed:            true,
+			},
+			"fsf_libre": schema.BoolAttribute{
+				MarkdownDescription: "Whether the license is considered free by the Free Software Foundation",
+				Computed:            true,
+			},
+			"deprecated": schema.BoolAttribute{
+				MarkdownDescription: "Whether the license ID is deprecated in the SPDX license list",
+				Computed:            true,
+			},
+			"custom": schema.BoolAttribute{
+				MarkdownDescription: "Whether the license is a custom license instead of an SPDX license",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *LicenseDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state LicenseDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	licenseID := state.LicenseID.ValueString()
+
+	licenses, err := FetchLicensesByID(ctx, d.Client, []string{licenseID})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read licenses, got error: %s", err))
+		return
+	}
+
+	license, found := licenses[licenseID]
+	if !found {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("The license %s could not be found", licenseID))
+		return
+	}
+
+	state.ID = types.StringValue(license.UUID.String())
+	state.LicenseID = types.StringValue(license.LicenseID)
+	state.Name = types.StringValue(license.Name)
+	state.OSIApproved = types.BoolValue(license.IsOSIApproved)
+	state.FSFLibre = types.BoolValue(license.IsFSFLibre)
+	state.Deprecated = types.BoolValue(license.IsDeprecatedLicenseID)
+	state.Custom = types.BoolValue(license.IsCustomLicense)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,91 @@
+// Copyright (c) 2024 Futurice Oy
+// SPDX-License-Identifier: MPL-2.0
+
+package license_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/futurice/terraform-provider-dependencytrack/internal/testutils"
+	licensetestutils "github.com/futurice/terraform-provider-dependencytrack/internal/testutils/license"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccLicenseDataSource_basic(t *testing.T) {
+	licenseDataSourceName := licensetestutils.CreateLicenseDataSourceName("test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLicenseDataSourceConfigBasic(testDependencyTrack, "Apache-2.0"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(licenseDataSourceName, "id"),
+					resource.TestCheckResourceAttr(licenseDataSourceName, "license_id", "Apache-2.0"),
+					resource.TestCheckResourceAttr(licenseDataSourceName, "name", "Apache License 2.0"),
+					resource.TestCheckResourceAttr(licenseDataSourceName, "osi_approved", "true"),
+					resource.TestCheckResourceAttr(licenseDataSourceName, "fsf_libre", "true"),
+					resource.TestCheckResourceAttr(licenseDataSourceName, "deprecated", "false"),
+					resource.TestCheckResourceAttr(licenseDataSourceName, "custom", "false"),
+				),
+			},
+			{
+				// Far down the list of licenses, so that several pages need to be fetched
+				Config: testAccLicenseDataSourceConfigBasic(testDependencyTrack, "Zlib"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(licenseDataSourceName, "id"),
+					resource.TestCheckResourceAttr(licenseDataSourceName, "license_id", "Zlib"),
+					resource.TestCheckResourceAttr(licenseDataSourceName, "name", "zlib License"),
+				),
+			},
+			{
+				Config: testAccLicenseDataSourceConfigBasic(testDependencyTrack, "GPL-2.0+"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(licenseDataSourceName, "license_id", "GPL-2.0+"),
+					resource.TestCheckResourceAttr(licenseDataSourceName, "deprecated", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLicenseDataSource_notFound(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccLicenseDataSourceConfigBasic(testDependencyTrack, "Nonexistent-License-1.0"),
+				ExpectError: regexp.MustCompile("The license Nonexistent-License-1.0 could not be found"),
+			},
+		},
+	})
+}
+
+func TestAccLicenseDataSource_invalid(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccLicenseDataSourceConfigBasic(testDependencyTrack, "Apache License 2.0"),
+				ExpectError: regexp.MustCompile("Invalid SPDX License Identifier"),
+			},
+		},
+	})
+}
+
+func testAccLicenseDataSourceConfigBasic(testDependencyTrack *testutils.TestDependencyTrack, licenseID string) string {
+	return testDependencyTrack.AddProviderConfiguration(
+		fmt.Sprintf(`
+data "dependencytrack_license" "test" {
+	license_id = %[1]q
+}
+`,
+			licenseID,
+		),
+	)
+}
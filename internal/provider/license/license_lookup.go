@@ -0,0 +1,48 @@
+// Copyright (c) 2024 Futurice Oy
+// SPDX-License-Identifier: MPL-2.0
+
+package license
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	dtrack "github.com/futurice/dependency-track-client-go"
+)
+
+// licensesPageSize is the page size used when going through the licenses. There are several hundred SPDX licenses, so
+// unlike most lists in Dependency-Track, the licenses can not be fetched in one request.
+const licensesPageSize = 100
+
+var errAllLicensesFound = errors.New("all licenses found")
+
+// FetchLicensesByID looks up the licenses with the given SPDX license IDs from the paginated list of licenses. The
+// pages are fetched only until all the licenses have been found. Licenses which do not exist are missing from the
+// returned map.
+func FetchLicensesByID(ctx context.Context, client *dtrack.Client, licenseIDs []string) (map[string]dtrack.License, error) {
+	licenses := make(map[string]dtrack.License, len(licenseIDs))
+	if len(licenseIDs) == 0 {
+		return licenses, nil
+	}
+
+	err := dtrack.ForEach(func(po dtrack.PageOptions) (dtrack.Page[dtrack.License], error) {
+		po.PageSize = licensesPageSize
+		return client.License.GetAll(ctx, po)
+	}, func(license dtrack.License) error {
+		if slices.Contains(licenseIDs, license.LicenseID) {
+			licenses[license.LicenseID] = license
+		}
+
+		if len(licenses) == len(licenseIDs) {
+			return errAllLicensesFound
+		}
+
+		return nil
+	})
+	if err != nil && !errors.Is(err, errAllLicensesFound) {
+		return nil, err
+	}
+
+	return licenses, nil
+}
@@ -0,0 +1,192 @@
+// Copyright (c) 2024 Futurice Oy
+// SPDX-License-Identifier: MPL-2.0
+
+package license
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	dtrack "github.com/futurice/dependency-track-client-go"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/providerdata"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/utils"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &LicenseResource{}
+var _ resource.ResourceWithImportState = &LicenseResource{}
+
+func NewLicenseResource() resource.Resource {
+	return &LicenseResource{}
+}
+
+// LicenseResource defines the resource implementation.
+type LicenseResource struct {
+	providerdata.ResourceBase
+}
+
+// LicenseResourceModel describes the resource data model.
+type LicenseResourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	LicenseID types.String `tfsdk:"license_id"`
+	Name      types.String `tfsdk:"name"`
+	Text      types.String `tfsdk:"text"`
+	Comment   types.String `tfsdk:"comment"`
+}
+
+func (r *LicenseResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_license"
+}
+
+func (r *LicenseResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Custom license, e.g. a proprietary license. Dependency-Track has no API for updating licenses, " +
+			"so any change replaces the license.",
+
+		Attributes: map[string]schema.Attribute{
+			"license_id": schema.StringAttribute{
+				MarkdownDescription: "License ID, e.g. `LicenseRef-Proprietary`. Must not be the ID of an existing license.",
+				Required:            true,
+				Validators: []validator.String{
+					validators.SPDXLicenseID(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the license",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"text": schema.StringAttribute{
+				MarkdownDescription: "Text of the license",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"comment": schema.StringAttribute{
+				MarkdownDescription: "Comment on the license",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "License UUID",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *LicenseResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan LicenseResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	respLicense, err := r.Client.License.Create(ctx, TFLicenseToDTLicense(plan))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create license, got error: %s", err))
+		return
+	}
+
+	plan = DTLicenseToTFLicense(respLicense)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *LicenseResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state LicenseResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	respLicense, err := r.Client.License.Get(ctx, state.LicenseID.ValueString())
+	if err != nil {
+		var apiErr *dtrack.APIError
+		if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read license, got error: %s", err))
+		return
+	}
+
+	if !respLicense.IsCustomLicense {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("License %s is not a custom license, and can not be managed as a resource", respLicense.LicenseID))
+		return
+	}
+
+	state = DTLicenseToTFLicense(respLicense)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *LicenseResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.AddError("Internal Error", "License resource is immutable")
+}
+
+func (r *LicenseResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state LicenseResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.Client.License.Delete(ctx, state.LicenseID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete license, got error: %s", err))
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// ImportState imports a custom license by its license ID.
+func (r *LicenseResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("license_id"), req, resp)
+}
+
+func DTLicenseToTFLicense(dtLicense dtrack.License) LicenseResourceModel {
+	return LicenseResourceModel{
+		ID:        types.StringValue(dtLicense.UUID.String()),
+		LicenseID: types.StringValue(dtLicense.LicenseID),
+		Name:      types.StringValue(dtLicense.Name),
+		Text:      utils.StringValueOrNull(dtLicense.Text),
+		Comment:   utils.StringValueOrNull(dtLicense.Comment),
+	}
+}
+
+func TFLicenseToDTLicense(tfLicense LicenseResourceModel) dtrack.License {
+	return dtrack.License{
+		LicenseID:       tfLicense.LicenseID.ValueString(),
+		Name:            tfLicense.Name.ValueString(),
+		Text:            tfLicense.Text.ValueString(),
+		Comment:         tfLicense.Comment.ValueString(),
+		IsCustomLicense: true,
+	}
+}
@@ -0,0 +1,82 @@
+// Copyright (c) 2024 Futurice Oy
+// SPDX-License-Identifier: MPL-2.0
+
+package license_test
+
+import (
+	"fmt"
+	"testing"
+
+	dtrack "github.com/futurice/dependency-track-client-go"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/testutils"
+	licensetestutils "github.com/futurice/terraform-provider-dependencytrack/internal/testutils/license"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccLicenseResource_basic(t *testing.T) {
+	ctx := testutils.CreateTestContext(t)
+
+	licenseResourceName := licensetestutils.CreateLicenseResourceName("test")
+
+	testLicense := dtrack.License{
+		LicenseID:       acctest.RandomWithPrefix("LicenseRef-test"),
+		Name:            acctest.RandomWithPrefix("Test License"),
+		Text:            "All rights reserved.",
+		Comment:         "Proprietary",
+		IsCustomLicense: true,
+	}
+
+	testUpdatedLicense := testLicense
+	testUpdatedLicense.Name = acctest.RandomWithPrefix("Other Test License")
+	testUpdatedLicense.Text = "All rights reserved, really."
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLicenseConfigBasic(testDependencyTrack, testLicense),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					licensetestutils.TestAccCheckLicenseExistsAndHasExpectedData(ctx, testDependencyTrack, testLicense),
+					resource.TestCheckResourceAttrSet(licenseResourceName, "id"),
+					resource.TestCheckResourceAttr(licenseResourceName, "license_id", testLicense.LicenseID),
+					resource.TestCheckResourceAttr(licenseResourceName, "name", testLicense.Name),
+					resource.TestCheckResourceAttr(licenseResourceName, "text", testLicense.Text),
+					resource.TestCheckResourceAttr(licenseResourceName, "comment", testLicense.Comment),
+				),
+			},
+			{
+				ResourceName:                         licenseResourceName,
+				ImportState:                          true,
+				ImportStateId:                        testLicense.LicenseID,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "license_id",
+			},
+			{
+				Config: testAccLicenseConfigBasic(testDependencyTrack, testUpdatedLicense),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					licensetestutils.TestAccCheckLicenseExistsAndHasExpectedData(ctx, testDependencyTrack, testUpdatedLicense),
+					resource.TestCheckResourceAttr(licenseResourceName, "name", testUpdatedLicense.Name),
+					resource.TestCheckResourceAttr(licenseResourceName, "text", testUpdatedLicense.Text),
+				),
+			},
+		},
+		CheckDestroy: licensetestutils.TestAccCheckLicenseDoesNotExists(ctx, testDependencyTrack, testLicense.LicenseID),
+	})
+}
+
+func testAccLicenseConfigBasic(testDependencyTrack *testutils.TestDependencyTrack, license dtrack.License) string {
+	return testDependencyTrack.AddProviderConfiguration(
+		fmt.Sprintf(`
+resource "dependencytrack_license" "test" {
+	license_id = %[1]q
+	name       = %[2]q
+	text       = %[3]q
+	comment    = %[4]q
+}
+`,
+			license.LicenseID, license.Name, license.Text, license.Comment,
+		),
+	)
+}
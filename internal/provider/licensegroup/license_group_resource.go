@@ -0,0 +1,314 @@
+// Copyright (c) 2024 Futurice Oy
+// SPDX-License-Identifier: MPL-2.0
+
+package licensegroup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	dtrack "github.com/futurice/dependency-track-client-go"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/provider/license"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/providerdata"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/utils"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/validators"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &LicenseGroupResource{}
+var _ resource.ResourceWithImportState = &LicenseGroupResource{}
+
+func NewLicenseGroupResource() resource.Resource {
+	return &LicenseGroupResource{}
+}
+
+// LicenseGroupResource defines the resource implementation.
+type LicenseGroupResource struct {
+	providerdata.ResourceBase
+}
+
+// LicenseGroupResourceModel describes the resource data model.
+type LicenseGroupResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	Name       types.String `tfsdk:"name"`
+	RiskWeight types.Int64  `tfsdk:"risk_weight"`
+	Licenses   types.Set    `tfsdk:"licenses"`
+}
+
+func (r *LicenseGroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_license_group"
+}
+
+func (r *LicenseGroupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "License group. License groups can be used in policy conditions with the LICENSE_GROUP subject.",
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the license group",
+				Required:            true,
+			},
+			"risk_weight": schema.Int64Attribute{
+				MarkdownDescription: "Risk weight of the license group. Default is 0.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(0),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"licenses": schema.SetAttribute{
+				MarkdownDescription: "License IDs of the licenses in the group, e.g. `Apache-2.0`. " +
+					"The licenses are managed authoritatively, i.e. licenses not listed here are removed from the group.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				Default:     setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(validators.SPDXLicenseID()),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "License group UUID",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *LicenseGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan LicenseGroupResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var licenseIDs []string
+	resp.Diagnostics.Append(plan.Licenses.ElementsAs(ctx, &licenseIDs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	respGroup, err := r.Client.LicenseGroup.Create(ctx, dtrack.LicenseGroup{
+		Name:       plan.Name.ValueString(),
+		RiskWeight: int(plan.RiskWeight.ValueInt64()),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create license group, got error: %s", err))
+		return
+	}
+
+	// Save the group to the state already, so that it is not left dangling if adding the licenses fails
+	state, diags := DTLicenseGroupToTFLicenseGroup(ctx, respGroup)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	respGroup, diags = r.setLicenses(ctx, respGroup, licenseIDs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, diags = DTLicenseGroupToTFLicenseGroup(ctx, respGroup)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *LicenseGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state LicenseGroupResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groupID, groupIDDiags := utils.ParseAttributeUUID(state.ID.ValueString(), "id")
+	resp.Diagnostics.Append(groupIDDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	respGroup, err := r.Client.LicenseGroup.Get(ctx, groupID)
+	if err != nil {
+		var apiErr *dtrack.APIError
+		if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read license group, got error: %s", err))
+		return
+	}
+
+	state, diags := DTLicenseGroupToTFLicenseGroup(ctx, respGroup)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *LicenseGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state LicenseGroupResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groupID, groupIDDiags := utils.ParseAttributeUUID(state.ID.ValueString(), "id")
+	resp.Diagnostics.Append(groupIDDiags...)
+
+	var licenseIDs []string
+	resp.Diagnostics.Append(plan.Licenses.ElementsAs(ctx, &licenseIDs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The licenses of the group are not changed by the update, they are managed separately below
+	respGroup, err := r.Client.LicenseGroup.Update(ctx, dtrack.LicenseGroup{
+		UUID:       groupID,
+		Name:       plan.Name.ValueString(),
+		RiskWeight: int(plan.RiskWeight.ValueInt64()),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update license group, got error: %s", err))
+		return
+	}
+
+	respGroup, diags := r.setLicenses(ctx, respGroup, licenseIDs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, diags = DTLicenseGroupToTFLicenseGroup(ctx, respGroup)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *LicenseGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state LicenseGroupResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groupID, groupIDDiags := utils.ParseAttributeUUID(state.ID.ValueString(), "id")
+	resp.Diagnostics.Append(groupIDDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.Client.LicenseGroup.Delete(ctx, groupID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete license group, got error: %s", err))
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *LicenseGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// setLicenses adds and removes licenses of the group so that it contains exactly the licenses with licenseIDs.
+func (r *LicenseGroupResource) setLicenses(ctx context.Context, group dtrack.LicenseGroup, licenseIDs []string) (dtrack.LicenseGroup, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var toAdd []string
+	for _, licenseID := range licenseIDs {
+		if !slices.ContainsFunc(group.Licenses, func(l dtrack.License) bool { return l.LicenseID == licenseID }) {
+			toAdd = append(toAdd, licenseID)
+		}
+	}
+
+	var toRemove []uuid.UUID
+	for _, l := range group.Licenses {
+		if !slices.Contains(licenseIDs, l.LicenseID) {
+			toRemove = append(toRemove, l.UUID)
+		}
+	}
+
+	if len(toAdd) == 0 && len(toRemove) == 0 {
+		return group, diags
+	}
+
+	// The licenses are added by their UUIDs, which need to be looked up from the licenses
+	licenses, err := license.FetchLicensesByID(ctx, r.Client, toAdd)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read licenses, got error: %s", err))
+		return group, diags
+	}
+
+	var missing []string
+	for _, licenseID := range toAdd {
+		if _, found := licenses[licenseID]; !found {
+			missing = append(missing, licenseID)
+		}
+	}
+	if len(missing) > 0 {
+		diags.AddAttributeError(path.Root("licenses"), "Unknown License",
+			fmt.Sprintf("The following licenses do not exist: [%s].", strings.Join(missing, ", ")))
+		return group, diags
+	}
+
+	for _, licenseID := range toAdd {
+		group, err = r.Client.LicenseGroup.AddLicense(ctx, group.UUID, licenses[licenseID].UUID)
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to add license %s to license group, got error: %s", licenseID, err))
+			return group, diags
+		}
+	}
+
+	for _, licenseUUID := range toRemove {
+		group, err = r.Client.LicenseGroup.RemoveLicense(ctx, group.UUID, licenseUUID)
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to remove license %s from license group, got error: %s", licenseUUID, err))
+			return group, diags
+		}
+	}
+
+	return group, diags
+}
+
+func DTLicenseGroupToTFLicenseGroup(ctx context.Context, dtGroup dtrack.LicenseGroup) (LicenseGroupResourceModel, diag.Diagnostics) {
+	licenseIDs := make([]string, len(dtGroup.Licenses))
+	for i, l := range dtGroup.Licenses {
+		licenseIDs[i] = l.LicenseID
+	}
+
+	licenses, diags := types.SetValueFrom(ctx, types.StringType, licenseIDs)
+
+	return LicenseGroupResourceModel{
+		ID:         types.StringValue(dtGroup.UUID.String()),
+		Name:       types.StringValue(dtGroup.Name),
+		RiskWeight: types.Int64Value(int64(dtGroup.RiskWeight)),
+		Licenses:   licenses,
+	}, diags
+}
@@ -0,0 +1,178 @@
+// Copyright (c) 2024 Futurice Oy
+// SPDX-License-Identifier: MPL-2.0
+
+package licensegroup_test
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strconv"
+	"testing"
+
+	dtrack "github.com/futurice/dependency-track-client-go"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/testutils"
+	licensetestutils "github.com/futurice/terraform-provider-dependencytrack/internal/testutils/license"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+var testDependencyTrack *testutils.TestDependencyTrack
+
+func TestMain(m *testing.M) {
+	if os.Getenv(resource.EnvTfAcc) != "" {
+		var cleanup func()
+		testDependencyTrack, cleanup = testutils.InitTestDependencyTrack()
+		defer cleanup()
+	}
+
+	m.Run()
+}
+
+func TestAccLicenseGroupResource_basic(t *testing.T) {
+	ctx := testutils.CreateTestContext(t)
+
+	groupResourceName := licensetestutils.CreateLicenseGroupResourceName("test")
+
+	testGroup := dtrack.LicenseGroup{
+		Name:       acctest.RandomWithPrefix("test-license-group"),
+		RiskWeight: 0,
+	}
+	testLicenseIDs := []string{"Apache-2.0", "MIT"}
+
+	testUpdatedGroup := testGroup
+	testUpdatedGroup.Name = acctest.RandomWithPrefix("other-test-license-group")
+	testUpdatedGroup.RiskWeight = 5
+	testUpdatedLicenseIDs := []string{"MIT", "BSD-3-Clause", "Zlib"}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLicenseGroupConfigBasic(testDependencyTrack, testGroup, testLicenseIDs),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					licensetestutils.TestAccCheckLicenseGroupExistsAndHasExpectedData(ctx, testDependencyTrack, groupResourceName, testGroup, testLicenseIDs),
+					resource.TestCheckResourceAttrSet(groupResourceName, "id"),
+					resource.TestCheckResourceAttr(groupResourceName, "name", testGroup.Name),
+					resource.TestCheckResourceAttr(groupResourceName, "risk_weight", "0"),
+					resource.TestCheckResourceAttr(groupResourceName, "licenses.#", "2"),
+					resource.TestCheckTypeSetElemAttr(groupResourceName, "licenses.*", "Apache-2.0"),
+					resource.TestCheckTypeSetElemAttr(groupResourceName, "licenses.*", "MIT"),
+				),
+			},
+			{
+				ResourceName:      groupResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccLicenseGroupConfigBasic(testDependencyTrack, testUpdatedGroup, testUpdatedLicenseIDs),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					licensetestutils.TestAccCheckLicenseGroupExistsAndHasExpectedData(ctx, testDependencyTrack, groupResourceName, testUpdatedGroup, testUpdatedLicenseIDs),
+					resource.TestCheckResourceAttr(groupResourceName, "name", testUpdatedGroup.Name),
+					resource.TestCheckResourceAttr(groupResourceName, "risk_weight", strconv.Itoa(testUpdatedGroup.RiskWeight)),
+					resource.TestCheckResourceAttr(groupResourceName, "licenses.#", "3"),
+				),
+			},
+			{
+				Config: testAccLicenseGroupConfigNoLicenses(testDependencyTrack, testUpdatedGroup),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					licensetestutils.TestAccCheckLicenseGroupExistsAndHasExpectedData(ctx, testDependencyTrack, groupResourceName, testUpdatedGroup, []string{}),
+					resource.TestCheckResourceAttr(groupResourceName, "licenses.#", "0"),
+				),
+			},
+		},
+		CheckDestroy: licensetestutils.TestAccCheckLicenseGroupDoesNotExists(ctx, testDependencyTrack, groupResourceName),
+	})
+}
+
+func TestAccLicenseGroupResource_policyCondition(t *testing.T) {
+	groupResourceName := licensetestutils.CreateLicenseGroupResourceName("test")
+
+	var groupID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLicenseGroupConfigPolicyCondition(testDependencyTrack, acctest.RandomWithPrefix("test-license-group")),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testutils.TestAccCheckGetResourceID(groupResourceName, &groupID),
+					resource.TestCheckResourceAttrPtr("dependencytrack_policy_condition.test", "value", &groupID),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLicenseGroupResource_unknownLicense(t *testing.T) {
+	testGroup := dtrack.LicenseGroup{
+		Name: acctest.RandomWithPrefix("test-license-group"),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccLicenseGroupConfigBasic(testDependencyTrack, testGroup, []string{"MIT", "Nonexistent-License-1.0"}),
+				ExpectError: regexp.MustCompile(`The following licenses do not exist: \[Nonexistent-License-1.0\]`),
+			},
+		},
+	})
+}
+
+func testAccLicenseGroupConfigBasic(testDependencyTrack *testutils.TestDependencyTrack, group dtrack.LicenseGroup, licenseIDs []string) string {
+	return testDependencyTrack.AddProviderConfiguration(
+		fmt.Sprintf(`
+resource "dependencytrack_license_group" "test" {
+	name        = %[1]q
+	risk_weight = %[2]d
+	licenses    = [%[3]s]
+}
+`,
+			group.Name, group.RiskWeight, testutils.QuoteList(licenseIDs),
+		),
+	)
+}
+
+func testAccLicenseGroupConfigNoLicenses(testDependencyTrack *testutils.TestDependencyTrack, group dtrack.LicenseGroup) string {
+	return testDependencyTrack.AddProviderConfiguration(
+		fmt.Sprintf(`
+resource "dependencytrack_license_group" "test" {
+	name        = %[1]q
+	risk_weight = %[2]d
+}
+`,
+			group.Name, group.RiskWeight,
+		),
+	)
+}
+
+func testAccLicenseGroupConfigPolicyCondition(testDependencyTrack *testutils.TestDependencyTrack, groupName string) string {
+	return testDependencyTrack.AddProviderConfiguration(
+		fmt.Sprintf(`
+resource "dependencytrack_license_group" "test" {
+	name     = %[1]q
+	licenses = ["GPL-3.0-only", "AGPL-3.0-only"]
+}
+
+resource "dependencytrack_policy" "test" {
+	name            = %[1]q
+	operator        = "ANY"
+	violation_state = "FAIL"
+}
+
+resource "dependencytrack_policy_condition" "test" {
+	policy_id = dependencytrack_policy.test.id
+	subject   = "LICENSE_GROUP"
+	operator  = "IS"
+	value     = dependencytrack_license_group.test.id
+}
+`,
+			groupName,
+		),
+	)
+}
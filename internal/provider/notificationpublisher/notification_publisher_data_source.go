@@ -7,8 +7,7 @@ import (
 	"context"
 	"fmt"
 
-	dtrack "github.com/futurice/dependency-track-client-go"
-
+	"github.com/futurice/terraform-provider-dependencytrack/internal/providerdata"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -23,7 +22,7 @@ func NewNotificationPublisherDataSource() datasource.DataSource {
 
 // NotificationPublisherDataSource defines the data source implementation.
 type NotificationPublisherDataSource struct {
-	client *dtrack.Client
+	providerdata.DataSourceBase
 }
 
 // NotificationPublisherDataSourceModel describes the data source data model.
@@ -78,25 +77,6 @@ func (d *NotificationPublisherDataSource) Schema(ctx context.Context, req dataso
 	}
 }
 
-func (d *NotificationPublisherDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
-	if req.ProviderData == nil {
-		return
-	}
-
-	client, ok := req.ProviderData.(*dtrack.Client)
-
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *dtrack.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
-
-		return
-	}
-
-	d.client = client
-}
-
 func (d *NotificationPublisherDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var state NotificationPublisherDataSourceModel
 
@@ -105,7 +85,7 @@ func (d *NotificationPublisherDataSource) Read(ctx context.Context, req datasour
 		return
 	}
 
-	publishers, err := d.client.Notification.GetAllPublishers(ctx)
+	publishers, err := d.Client.Notification.GetAllPublishers(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read team, got error: %s", err))
 		return
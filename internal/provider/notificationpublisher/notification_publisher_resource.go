@@ -14,6 +14,7 @@ import (
 	dtrack "github.com/futurice/dependency-track-client-go"
 	"github.com/google/uuid"
 
+	"github.com/futurice/terraform-provider-dependencytrack/internal/providerdata"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -33,7 +34,7 @@ func NewNotificationPublisherResource() resource.Resource {
 
 // NotificationPublisherResource defines the resource implementation.
 type NotificationPublisherResource struct {
-	client *dtrack.Client
+	providerdata.ResourceBase
 }
 
 // NotificationPublisherResourceModel describes the resource data model.
@@ -86,25 +87,6 @@ func (r *NotificationPublisherResource) Schema(ctx context.Context, req resource
 	}
 }
 
-func (r *NotificationPublisherResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if req.ProviderData == nil {
-		return
-	}
-
-	client, ok := req.ProviderData.(*dtrack.Client)
-
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *dtrack.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
-
-		return
-	}
-
-	r.client = client
-}
-
 func (r *NotificationPublisherResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan NotificationPublisherResourceModel
 
@@ -116,7 +98,7 @@ func (r *NotificationPublisherResource) Create(ctx context.Context, req resource
 	dtPublisher, diags := TFPublisherToDTPublisher(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 
-	respPublisher, err := r.client.Notification.CreatePublisher(ctx, dtPublisher)
+	respPublisher, err := r.Client.Notification.CreatePublisher(ctx, dtPublisher)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create notification publisher, got error: %s", err))
 		return
@@ -136,7 +118,7 @@ func (r *NotificationPublisherResource) Read(ctx context.Context, req resource.R
 		return
 	}
 
-	publishers, err := r.client.Notification.GetAllPublishers(ctx)
+	publishers, err := r.Client.Notification.GetAllPublishers(ctx)
 	if err != nil {
 		var apiErr *dtrack.APIError
 		if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
@@ -182,7 +164,7 @@ func (r *NotificationPublisherResource) Update(ctx context.Context, req resource
 	dtPublisher, diags := TFPublisherToDTPublisher(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 
-	respPublisher, err := r.client.Notification.UpdatePublisher(ctx, dtPublisher)
+	respPublisher, err := r.Client.Notification.UpdatePublisher(ctx, dtPublisher)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update notification publisher, got error: %s", err))
 		return
@@ -208,7 +190,7 @@ func (r *NotificationPublisherResource) Delete(ctx context.Context, req resource
 		return
 	}
 
-	err := r.client.Notification.DeletePublisher(ctx, publisherID)
+	err := r.Client.Notification.DeletePublisher(ctx, publisherID)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete notification publisher, got error: %s", err))
 		return
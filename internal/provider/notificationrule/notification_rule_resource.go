@@ -10,6 +10,7 @@ import (
 	"net/http"
 
 	dtrack "github.com/futurice/dependency-track-client-go"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/providerdata"
 	"github.com/futurice/terraform-provider-dependencytrack/internal/utils"
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -34,7 +35,7 @@ func NewNotificationRuleResource() resource.Resource {
 
 // NotificationRuleResource defines the resource implementation.
 type NotificationRuleResource struct {
-	client *dtrack.Client
+	providerdata.ResourceBase
 }
 
 // NotificationRuleResourceModel describes the resource data model.
@@ -118,25 +119,6 @@ func (r *NotificationRuleResource) Schema(ctx context.Context, req resource.Sche
 	}
 }
 
-func (r *NotificationRuleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if req.ProviderData == nil {
-		return
-	}
-
-	client, ok := req.ProviderData.(*dtrack.Client)
-
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *dtrack.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
-
-		return
-	}
-
-	r.client = client
-}
-
 func (r *NotificationRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan NotificationRuleResourceModel
 
@@ -149,7 +131,7 @@ func (r *NotificationRuleResource) Create(ctx context.Context, req resource.Crea
 	dtRule, diags := TFRuleToDTRule(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 
-	respRule, err := r.client.Notification.CreateRule(ctx, dtRule)
+	respRule, err := r.Client.Notification.CreateRule(ctx, dtRule)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create notification rule, got error: %s", err))
 		return
@@ -158,7 +140,7 @@ func (r *NotificationRuleResource) Create(ctx context.Context, req resource.Crea
 	// Some attributes can not be set on creation
 	if dtRule.PublisherConfig != "" {
 		dtRule.UUID = respRule.UUID
-		respRule, err = r.client.Notification.UpdateRule(ctx, dtRule)
+		respRule, err = r.Client.Notification.UpdateRule(ctx, dtRule)
 		if err != nil {
 			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update notification rule, got error: %s", err))
 			return
@@ -180,7 +162,7 @@ func (r *NotificationRuleResource) Read(ctx context.Context, req resource.ReadRe
 		return
 	}
 
-	rules, err := r.client.Notification.GetAllRules(ctx)
+	rules, err := r.Client.Notification.GetAllRules(ctx)
 	if err != nil {
 		var apiErr *dtrack.APIError
 		if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
@@ -224,7 +206,7 @@ func (r *NotificationRuleResource) Update(ctx context.Context, req resource.Upda
 	dtRule, diags := TFRuleToDTRule(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 
-	respRule, err := r.client.Notification.UpdateRule(ctx, dtRule)
+	respRule, err := r.Client.Notification.UpdateRule(ctx, dtRule)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update notification rule, got error: %s", err))
 		return
@@ -250,7 +232,7 @@ func (r *NotificationRuleResource) Delete(ctx context.Context, req resource.Dele
 		return
 	}
 
-	err := r.client.Notification.DeleteRule(ctx, ruleID)
+	err := r.Client.Notification.DeleteRule(ctx, ruleID)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete notification rule, got error: %s", err))
 		return
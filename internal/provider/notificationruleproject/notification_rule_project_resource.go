@@ -8,7 +8,7 @@ import (
 	"fmt"
 	"strings"
 
-	dtrack "github.com/futurice/dependency-track-client-go"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/providerdata"
 	"github.com/futurice/terraform-provider-dependencytrack/internal/utils"
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -29,7 +29,7 @@ func NewNotificationRuleProjectResource() resource.Resource {
 
 // NotificationRuleProjectResource defines the resource implementation.
 type NotificationRuleProjectResource struct {
-	client *dtrack.Client
+	providerdata.ResourceBase
 }
 
 // NotificationRuleProjectResourceModel describes the resource data model.
@@ -70,25 +70,6 @@ func (r *NotificationRuleProjectResource) Schema(ctx context.Context, req resour
 	}
 }
 
-func (r *NotificationRuleProjectResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if req.ProviderData == nil {
-		return
-	}
-
-	client, ok := req.ProviderData.(*dtrack.Client)
-
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *dtrack.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
-
-		return
-	}
-
-	r.client = client
-}
-
 func (r *NotificationRuleProjectResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan, state NotificationRuleProjectResourceModel
 
@@ -107,7 +88,7 @@ func (r *NotificationRuleProjectResource) Create(ctx context.Context, req resour
 		return
 	}
 
-	_, err := r.client.Notification.AddProjectToRule(ctx, ruleID, projectID)
+	_, err := r.Client.Notification.AddProjectToRule(ctx, ruleID, projectID)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create notification rule project, got error: %s", err))
 		return
@@ -129,7 +110,7 @@ func (r *NotificationRuleProjectResource) Read(ctx context.Context, req resource
 	}
 
 	// There is no API mehtod for a single rule, so we need to get all rules and filter
-	rules, err := r.client.Notification.GetAllRules(ctx)
+	rules, err := r.Client.Notification.GetAllRules(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read team, got error: %s", err))
 		return
@@ -179,7 +160,7 @@ func (r *NotificationRuleProjectResource) Delete(ctx context.Context, req resour
 		return
 	}
 
-	_, err := r.client.Notification.DeleteProjectFromRule(ctx, ruleID, projectID)
+	_, err := r.Client.Notification.DeleteProjectFromRule(ctx, ruleID, projectID)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete notification rule project relation, got error: %s", err))
 		return
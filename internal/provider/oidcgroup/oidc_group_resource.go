@@ -0,0 +1,199 @@
+// Copyright (c) 2024 Futurice Oy
+// SPDX-License-Identifier: MPL-2.0
+
+package oidcgroup
+
+import (
+	"context"
+	"fmt"
+
+	dtrack "github.com/futurice/dependency-track-client-go"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/providerdata"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/utils"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &OIDCGroupResource{}
+var _ resource.ResourceWithImportState = &OIDCGroupResource{}
+
+func NewOIDCGroupResource() resource.Resource {
+	return &OIDCGroupResource{}
+}
+
+// OIDCGroupResource defines the resource implementation.
+type OIDCGroupResource struct {
+	providerdata.ResourceBase
+}
+
+// OIDCGroupResourceModel describes the resource data model.
+type OIDCGroupResourceModel struct {
+	ID   types.String `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+}
+
+func (r *OIDCGroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_oidc_group"
+}
+
+func (r *OIDCGroupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "OIDC group. Users in the group at the identity provider become members of the teams the group is " +
+			"mapped to with `dependencytrack_oidc_group_mapping`.",
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the group, as given by the groups claim of the identity provider",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "OIDC group UUID",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *OIDCGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan OIDCGroupResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	respGroup, err := r.Client.OIDC.CreateGroup(ctx, plan.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create OIDC group, got error: %s", err))
+		return
+	}
+
+	plan = DTOIDCGroupToTFOIDCGroup(respGroup)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *OIDCGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state OIDCGroupResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groupID, groupIDDiags := utils.ParseAttributeUUID(state.ID.ValueString(), "id")
+	resp.Diagnostics.Append(groupIDDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	respGroup, err := r.findGroup(ctx, groupID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read OIDC group, got error: %s", err))
+		return
+	}
+	if respGroup == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state = DTOIDCGroupToTFOIDCGroup(*respGroup)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *OIDCGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state OIDCGroupResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groupID, groupIDDiags := utils.ParseAttributeUUID(state.ID.ValueString(), "id")
+	resp.Diagnostics.Append(groupIDDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	respGroup, err := r.Client.OIDC.UpdateGroup(ctx, dtrack.OIDCGroup{
+		UUID: groupID,
+		Name: plan.Name.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update OIDC group, got error: %s", err))
+		return
+	}
+
+	state = DTOIDCGroupToTFOIDCGroup(respGroup)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *OIDCGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state OIDCGroupResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groupID, groupIDDiags := utils.ParseAttributeUUID(state.ID.ValueString(), "id")
+	resp.Diagnostics.Append(groupIDDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.Client.OIDC.DeleteGroup(ctx, groupID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete OIDC group, got error: %s", err))
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *OIDCGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// findGroup looks up the group from all groups, as there is no endpoint for getting a single group. Nil is returned
+// if there is no such group.
+func (r *OIDCGroupResource) findGroup(ctx context.Context, groupID uuid.UUID) (*dtrack.OIDCGroup, error) {
+	groups, err := dtrack.FetchAll(func(po dtrack.PageOptions) (dtrack.Page[dtrack.OIDCGroup], error) {
+		return r.Client.OIDC.GetAllGroups(ctx, po)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, group := range groups {
+		if group.UUID == groupID {
+			return &group, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func DTOIDCGroupToTFOIDCGroup(dtGroup dtrack.OIDCGroup) OIDCGroupResourceModel {
+	return OIDCGroupResourceModel{
+		ID:   types.StringValue(dtGroup.UUID.String()),
+		Name: types.StringValue(dtGroup.Name),
+	}
+}
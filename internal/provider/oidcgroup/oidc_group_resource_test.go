@@ -0,0 +1,75 @@
+// Copyright (c) 2024 Futurice Oy
+// SPDX-License-Identifier: MPL-2.0
+
+package oidcgroup_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/futurice/terraform-provider-dependencytrack/internal/testutils"
+	oidctestutils "github.com/futurice/terraform-provider-dependencytrack/internal/testutils/oidc"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+var testDependencyTrack *testutils.TestDependencyTrack
+
+func TestMain(m *testing.M) {
+	if os.Getenv(resource.EnvTfAcc) != "" {
+		var cleanup func()
+		testDependencyTrack, cleanup = testutils.InitTestDependencyTrack()
+		defer cleanup()
+	}
+
+	m.Run()
+}
+
+func TestAccOIDCGroupResource_basic(t *testing.T) {
+	ctx := testutils.CreateTestContext(t)
+
+	groupName := acctest.RandomWithPrefix("test-oidc-group")
+	otherGroupName := acctest.RandomWithPrefix("other-test-oidc-group")
+	groupResourceName := oidctestutils.CreateOIDCGroupResourceName("test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOIDCGroupConfigBasic(testDependencyTrack, groupName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					oidctestutils.TestAccCheckOIDCGroupExistsAndHasExpectedName(ctx, testDependencyTrack, groupResourceName, groupName),
+					resource.TestCheckResourceAttrSet(groupResourceName, "id"),
+					resource.TestCheckResourceAttr(groupResourceName, "name", groupName),
+				),
+			},
+			{
+				ResourceName:      groupResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccOIDCGroupConfigBasic(testDependencyTrack, otherGroupName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					oidctestutils.TestAccCheckOIDCGroupExistsAndHasExpectedName(ctx, testDependencyTrack, groupResourceName, otherGroupName),
+					resource.TestCheckResourceAttr(groupResourceName, "name", otherGroupName),
+				),
+			},
+		},
+		CheckDestroy: oidctestutils.TestAccCheckOIDCGroupDoesNotExists(ctx, testDependencyTrack, groupResourceName),
+	})
+}
+
+func testAccOIDCGroupConfigBasic(testDependencyTrack *testutils.TestDependencyTrack, groupName string) string {
+	return testDependencyTrack.AddProviderConfiguration(
+		fmt.Sprintf(`
+resource "dependencytrack_oidc_group" "test" {
+	name = %[1]q
+}
+`,
+			groupName,
+		),
+	)
+}
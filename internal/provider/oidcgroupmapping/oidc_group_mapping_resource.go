@@ -0,0 +1,216 @@
+// Copyright (c) 2024 Futurice Oy
+// SPDX-License-Identifier: MPL-2.0
+
+package oidcgroupmapping
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	dtrack "github.com/futurice/dependency-track-client-go"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/provider/team"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/providerdata"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &OIDCGroupMappingResource{}
+var _ resource.ResourceWithImportState = &OIDCGroupMappingResource{}
+
+func NewOIDCGroupMappingResource() resource.Resource {
+	return &OIDCGroupMappingResource{}
+}
+
+// OIDCGroupMappingResource defines the resource implementation.
+type OIDCGroupMappingResource struct {
+	providerdata.ResourceBase
+}
+
+// OIDCGroupMappingResourceModel describes the resource data model.
+type OIDCGroupMappingResourceModel struct {
+	ID      types.String `tfsdk:"id"`
+	TeamID  types.String `tfsdk:"team_id"`
+	GroupID types.String `tfsdk:"group_id"`
+}
+
+func (r *OIDCGroupMappingResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_oidc_group_mapping"
+}
+
+func (r *OIDCGroupMappingResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Mapping of an OIDC group to a team. Users in the group become members of the team when they log in.",
+
+		Attributes: map[string]schema.Attribute{
+			"team_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the team",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"group_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the OIDC group",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Mapping UUID",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *OIDCGroupMappingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan OIDCGroupMappingResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	teamID, teamIDDiags := utils.ParseAttributeUUID(plan.TeamID.ValueString(), "team_id")
+	resp.Diagnostics.Append(teamIDDiags...)
+
+	groupID, groupIDDiags := utils.ParseAttributeUUID(plan.GroupID.ValueString(), "group_id")
+	resp.Diagnostics.Append(groupIDDiags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	respMapping, err := r.Client.OIDC.AddTeamMapping(ctx, dtrack.OIDCMappingRequest{
+		Team:  teamID,
+		Group: groupID,
+	})
+	if err != nil {
+		var apiErr *dtrack.APIError
+		if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusConflict {
+			resp.Diagnostics.AddError("Client Error", "The OIDC group is already mapped to the team")
+			return
+		}
+
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create OIDC group mapping, got error: %s", err))
+		return
+	}
+
+	plan.ID = types.StringValue(respMapping.UUID.String())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *OIDCGroupMappingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state OIDCGroupMappingResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	teamID, teamIDDiags := utils.ParseAttributeUUID(state.TeamID.ValueString(), "team_id")
+	resp.Diagnostics.Append(teamIDDiags...)
+
+	mappingID, mappingIDDiags := utils.ParseAttributeUUID(state.ID.ValueString(), "id")
+	resp.Diagnostics.Append(mappingIDDiags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	respTeam, err := team.FetchTeamWithOIDCMappings(ctx, r.Client, teamID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read team, got error: %s", err))
+		return
+	}
+	if respTeam == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	found := false
+	for _, mapping := range respTeam.MappedOIDCGroups {
+		if mapping.UUID == mappingID {
+			found = true
+			state.GroupID = types.StringValue(mapping.Group.UUID.String())
+			break
+		}
+	}
+
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *OIDCGroupMappingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.AddError("Internal Error", "OIDC group mapping resource is immutable")
+}
+
+func (r *OIDCGroupMappingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state OIDCGroupMappingResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	mappingID, mappingIDDiags := utils.ParseAttributeUUID(state.ID.ValueString(), "id")
+	resp.Diagnostics.Append(mappingIDDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.Client.OIDC.RemoveTeamMapping(ctx, mappingID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete OIDC group mapping, got error: %s", err))
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// ImportState imports a mapping by its UUID. The team of the mapping is looked up from all teams.
+func (r *OIDCGroupMappingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	mappingID, mappingIDDiags := utils.ParseUUID(req.ID)
+	resp.Diagnostics.Append(mappingIDDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	teams, err := dtrack.FetchAll(func(po dtrack.PageOptions) (dtrack.Page[dtrack.Team], error) {
+		return r.Client.Team.GetAll(ctx, po)
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get teams, got error: %s", err))
+		return
+	}
+
+	for _, t := range teams {
+		for _, mapping := range t.MappedOIDCGroups {
+			if mapping.UUID == mappingID {
+				resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), mappingID.String())...)
+				resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team_id"), t.UUID.String())...)
+				resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("group_id"), mapping.Group.UUID.String())...)
+				return
+			}
+		}
+	}
+
+	resp.Diagnostics.AddError("Cannot import non-existent remote object", fmt.Sprintf("No team has an OIDC group mapping with ID [%s]", req.ID))
+}
@@ -0,0 +1,141 @@
+// Copyright (c) 2024 Futurice Oy
+// SPDX-License-Identifier: MPL-2.0
+
+package oidcgroupmapping_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/futurice/terraform-provider-dependencytrack/internal/testutils"
+	oidctestutils "github.com/futurice/terraform-provider-dependencytrack/internal/testutils/oidc"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/testutils/teamtestutils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+var testDependencyTrack *testutils.TestDependencyTrack
+
+func TestMain(m *testing.M) {
+	if os.Getenv(resource.EnvTfAcc) != "" {
+		var cleanup func()
+		testDependencyTrack, cleanup = testutils.InitTestDependencyTrack()
+		defer cleanup()
+	}
+
+	m.Run()
+}
+
+func TestAccOIDCGroupMappingResource_basic(t *testing.T) {
+	ctx := testutils.CreateTestContext(t)
+
+	teamName := acctest.RandomWithPrefix("test-team")
+	groupName := acctest.RandomWithPrefix("test-oidc-group")
+
+	teamResourceName := teamtestutils.CreateTeamResourceName("test")
+	groupResourceName := oidctestutils.CreateOIDCGroupResourceName("test")
+	mappingResourceName := oidctestutils.CreateOIDCGroupMappingResourceName("test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOIDCGroupMappingConfigBasic(testDependencyTrack, teamName, groupName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					oidctestutils.TestAccCheckOIDCGroupMappingExists(ctx, testDependencyTrack, teamResourceName, groupResourceName),
+					resource.TestCheckResourceAttrSet(mappingResourceName, "id"),
+					resource.TestCheckResourceAttrPair(mappingResourceName, "team_id", teamResourceName, "id"),
+					resource.TestCheckResourceAttrPair(mappingResourceName, "group_id", groupResourceName, "id"),
+				),
+			},
+			{
+				ResourceName:      mappingResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccOIDCGroupMappingConfigNoMapping(testDependencyTrack, teamName, groupName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					oidctestutils.TestAccCheckOIDCGroupMappingDoesNotExists(ctx, testDependencyTrack, teamResourceName, groupResourceName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOIDCGroupMappingResource_disappears(t *testing.T) {
+	ctx := testutils.CreateTestContext(t)
+
+	teamName := acctest.RandomWithPrefix("test-team")
+	groupName := acctest.RandomWithPrefix("test-oidc-group")
+
+	mappingResourceName := oidctestutils.CreateOIDCGroupMappingResourceName("test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOIDCGroupMappingConfigBasic(testDependencyTrack, teamName, groupName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccOIDCGroupMappingDeleteOutsideTerraform(ctx, mappingResourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccOIDCGroupMappingDeleteOutsideTerraform(ctx context.Context, mappingResourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		mappingID, err := testutils.GetResourceID(state, mappingResourceName)
+		if err != nil {
+			return err
+		}
+
+		err = testDependencyTrack.Client.OIDC.RemoveTeamMapping(ctx, mappingID)
+		if err != nil {
+			return fmt.Errorf("failed to remove OIDC group mapping %s: %w", mappingID, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccOIDCGroupMappingConfigBasic(testDependencyTrack *testutils.TestDependencyTrack, teamName, groupName string) string {
+	return testDependencyTrack.AddProviderConfiguration(
+		testutils.ComposeConfigs(
+			testAccOIDCGroupMappingConfigTeamAndGroup(teamName, groupName),
+			`
+resource "dependencytrack_oidc_group_mapping" "test" {
+	team_id  = dependencytrack_team.test.id
+	group_id = dependencytrack_oidc_group.test.id
+}
+`,
+		),
+	)
+}
+
+func testAccOIDCGroupMappingConfigNoMapping(testDependencyTrack *testutils.TestDependencyTrack, teamName, groupName string) string {
+	return testDependencyTrack.AddProviderConfiguration(
+		testAccOIDCGroupMappingConfigTeamAndGroup(teamName, groupName),
+	)
+}
+
+func testAccOIDCGroupMappingConfigTeamAndGroup(teamName, groupName string) string {
+	return fmt.Sprintf(`
+resource "dependencytrack_team" "test" {
+	name = %[1]q
+}
+
+resource "dependencytrack_oidc_group" "test" {
+	name = %[2]q
+}
+`,
+		teamName, groupName,
+	)
+}
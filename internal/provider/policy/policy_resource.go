@@ -0,0 +1,255 @@
+// Copyright (c) 2024 Futurice Oy
+// SPDX-License-Identifier: MPL-2.0
+
+package policy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	dtrack "github.com/futurice/dependency-track-client-go"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/providerdata"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/utils"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &PolicyResource{}
+var _ resource.ResourceWithImportState = &PolicyResource{}
+
+func NewPolicyResource() resource.Resource {
+	return &PolicyResource{}
+}
+
+// PolicyResource defines the resource implementation.
+type PolicyResource struct {
+	providerdata.ResourceBase
+}
+
+// PolicyResourceModel describes the resource data model.
+type PolicyResourceModel struct {
+	ID                       types.String `tfsdk:"id"`
+	Name                     types.String `tfsdk:"name"`
+	Operator                 types.String `tfsdk:"operator"`
+	ViolationState           types.String `tfsdk:"violation_state"`
+	IncludeChildren          types.Bool   `tfsdk:"include_children"`
+	OnlyLatestProjectVersion types.Bool   `tfsdk:"only_latest_project_version"`
+}
+
+func (r *PolicyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_policy"
+}
+
+func (r *PolicyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Policy. The conditions of the policy are managed with `dependencytrack_policy_condition`.",
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the policy",
+				Required:            true,
+			},
+			"operator": schema.StringAttribute{
+				MarkdownDescription: "Whether any or all of the conditions must match for the policy to be violated. Possible values: [ANY, ALL]",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("ANY", "ALL"),
+				},
+			},
+			"violation_state": schema.StringAttribute{
+				MarkdownDescription: "State of the violations of the policy. Possible values: [INFO, WARN, FAIL]",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("INFO", "WARN", "FAIL"),
+				},
+			},
+			"include_children": schema.BoolAttribute{
+				MarkdownDescription: "Whether the policy also applies to the children of the projects it is assigned to. Default is false.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"only_latest_project_version": schema.BoolAttribute{
+				MarkdownDescription: "Whether the policy only applies to the latest versions of projects. Default is false.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Policy UUID",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *PolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan PolicyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dtPolicy, diags := TFPolicyToDTPolicy(plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	respPolicy, err := r.Client.Policy.Create(ctx, dtPolicy)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create policy, got error: %s", err))
+		return
+	}
+
+	// Some attributes can not be set on creation
+	if dtPolicy.IncludeChildren || dtPolicy.OnlyLatestProjectVersion {
+		dtPolicy.UUID = respPolicy.UUID
+		respPolicy, err = r.Client.Policy.Update(ctx, dtPolicy)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update policy, got error: %s", err))
+			return
+		}
+	}
+
+	plan = DTPolicyToTFPolicy(respPolicy)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *PolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state PolicyResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policyID, policyIDDiags := utils.ParseAttributeUUID(state.ID.ValueString(), "id")
+	resp.Diagnostics.Append(policyIDDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	respPolicy, err := r.Client.Policy.Get(ctx, policyID)
+	if err != nil {
+		var apiErr *dtrack.APIError
+		if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read policy, got error: %s", err))
+		return
+	}
+
+	state = DTPolicyToTFPolicy(respPolicy)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *PolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state PolicyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dtPolicy, diags := TFPolicyToDTPolicy(plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	respPolicy, err := r.Client.Policy.Update(ctx, dtPolicy)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update policy, got error: %s", err))
+		return
+	}
+
+	state = DTPolicyToTFPolicy(respPolicy)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *PolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state PolicyResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policyID, policyIDDiags := utils.ParseAttributeUUID(state.ID.ValueString(), "id")
+	resp.Diagnostics.Append(policyIDDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.Client.Policy.Delete(ctx, policyID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete policy, got error: %s", err))
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *PolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func DTPolicyToTFPolicy(dtPolicy dtrack.Policy) PolicyResourceModel {
+	return PolicyResourceModel{
+		ID:                       types.StringValue(dtPolicy.UUID.String()),
+		Name:                     types.StringValue(dtPolicy.Name),
+		Operator:                 types.StringValue(string(dtPolicy.Operator)),
+		ViolationState:           types.StringValue(string(dtPolicy.ViolationState)),
+		IncludeChildren:          types.BoolValue(dtPolicy.IncludeChildren),
+		OnlyLatestProjectVersion: types.BoolValue(dtPolicy.OnlyLatestProjectVersion),
+	}
+}
+
+func TFPolicyToDTPolicy(tfPolicy PolicyResourceModel) (dtrack.Policy, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	policy := dtrack.Policy{
+		Name:                     tfPolicy.Name.ValueString(),
+		Operator:                 dtrack.PolicyOperator(tfPolicy.Operator.ValueString()),
+		ViolationState:           dtrack.PolicyViolationState(tfPolicy.ViolationState.ValueString()),
+		IncludeChildren:          tfPolicy.IncludeChildren.ValueBool(),
+		OnlyLatestProjectVersion: tfPolicy.OnlyLatestProjectVersion.ValueBool(),
+	}
+
+	if tfPolicy.ID.IsUnknown() {
+		policy.UUID = uuid.Nil
+	} else {
+		policyID, policyIDDiags := utils.ParseAttributeUUID(tfPolicy.ID.ValueString(), "id")
+		diags.Append(policyIDDiags...)
+
+		policy.UUID = policyID
+	}
+
+	return policy, diags
+}
@@ -0,0 +1,120 @@
+// Copyright (c) 2024 Futurice Oy
+// SPDX-License-Identifier: MPL-2.0
+
+package policy_test
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strconv"
+	"testing"
+
+	dtrack "github.com/futurice/dependency-track-client-go"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/testutils"
+	policytestutils "github.com/futurice/terraform-provider-dependencytrack/internal/testutils/policy"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+var testDependencyTrack *testutils.TestDependencyTrack
+
+func TestMain(m *testing.M) {
+	if os.Getenv(resource.EnvTfAcc) != "" {
+		var cleanup func()
+		testDependencyTrack, cleanup = testutils.InitTestDependencyTrack()
+		defer cleanup()
+	}
+
+	m.Run()
+}
+
+func TestAccPolicyResource_basic(t *testing.T) {
+	ctx := testutils.CreateTestContext(t)
+
+	policyResourceName := policytestutils.CreatePolicyResourceName("test")
+
+	testPolicy := dtrack.Policy{
+		Name:           acctest.RandomWithPrefix("test-policy"),
+		Operator:       "ANY",
+		ViolationState: "WARN",
+	}
+
+	testUpdatedPolicy := testPolicy
+	testUpdatedPolicy.Name = acctest.RandomWithPrefix("other-test-policy")
+	testUpdatedPolicy.Operator = "ALL"
+	testUpdatedPolicy.ViolationState = "FAIL"
+	testUpdatedPolicy.IncludeChildren = true
+	testUpdatedPolicy.OnlyLatestProjectVersion = true
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyConfigBasic(testDependencyTrack, testPolicy),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					policytestutils.TestAccCheckPolicyExistsAndHasExpectedData(ctx, testDependencyTrack, policyResourceName, testPolicy),
+					resource.TestCheckResourceAttrSet(policyResourceName, "id"),
+					resource.TestCheckResourceAttr(policyResourceName, "name", testPolicy.Name),
+					resource.TestCheckResourceAttr(policyResourceName, "operator", string(testPolicy.Operator)),
+					resource.TestCheckResourceAttr(policyResourceName, "violation_state", string(testPolicy.ViolationState)),
+					resource.TestCheckResourceAttr(policyResourceName, "include_children", "false"),
+					resource.TestCheckResourceAttr(policyResourceName, "only_latest_project_version", "false"),
+				),
+			},
+			{
+				ResourceName:      policyResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPolicyConfigBasic(testDependencyTrack, testUpdatedPolicy),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					policytestutils.TestAccCheckPolicyExistsAndHasExpectedData(ctx, testDependencyTrack, policyResourceName, testUpdatedPolicy),
+					resource.TestCheckResourceAttr(policyResourceName, "name", testUpdatedPolicy.Name),
+					resource.TestCheckResourceAttr(policyResourceName, "operator", string(testUpdatedPolicy.Operator)),
+					resource.TestCheckResourceAttr(policyResourceName, "violation_state", string(testUpdatedPolicy.ViolationState)),
+					resource.TestCheckResourceAttr(policyResourceName, "include_children", strconv.FormatBool(testUpdatedPolicy.IncludeChildren)),
+					resource.TestCheckResourceAttr(policyResourceName, "only_latest_project_version", strconv.FormatBool(testUpdatedPolicy.OnlyLatestProjectVersion)),
+				),
+			},
+		},
+		CheckDestroy: policytestutils.TestAccCheckPolicyDoesNotExists(ctx, testDependencyTrack, policyResourceName),
+	})
+}
+
+func TestAccPolicyResource_invalid(t *testing.T) {
+	testPolicy := dtrack.Policy{
+		Name:           acctest.RandomWithPrefix("test-policy"),
+		Operator:       "SOME",
+		ViolationState: "WARN",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPolicyConfigBasic(testDependencyTrack, testPolicy),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Value Match`),
+			},
+		},
+	})
+}
+
+func testAccPolicyConfigBasic(testDependencyTrack *testutils.TestDependencyTrack, policy dtrack.Policy) string {
+	return testDependencyTrack.AddProviderConfiguration(
+		fmt.Sprintf(`
+resource "dependencytrack_policy" "test" {
+	name                        = %[1]q
+	operator                    = %[2]q
+	violation_state             = %[3]q
+	include_children            = %[4]t
+	only_latest_project_version = %[5]t
+}
+`,
+			policy.Name, policy.Operator, policy.ViolationState, policy.IncludeChildren, policy.OnlyLatestProjectVersion,
+		),
+	)
+}
@@ -0,0 +1,310 @@
+// Copyright (c) 2024 Futurice Oy
+// SPDX-License-Identifier: MPL-2.0
+
+package policycondition
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	dtrack "github.com/futurice/dependency-track-client-go"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/providerdata"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/utils"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &PolicyConditionResource{}
+var _ resource.ResourceWithImportState = &PolicyConditionResource{}
+var _ resource.ResourceWithValidateConfig = &PolicyConditionResource{}
+
+func NewPolicyConditionResource() resource.Resource {
+	return &PolicyConditionResource{}
+}
+
+// PolicyConditionResource defines the resource implementation.
+type PolicyConditionResource struct {
+	providerdata.ResourceBase
+}
+
+// PolicyConditionResourceModel describes the resource data model.
+type PolicyConditionResourceModel struct {
+	ID       types.String `tfsdk:"id"`
+	PolicyID types.String `tfsdk:"policy_id"`
+	Subject  types.String `tfsdk:"subject"`
+	Operator types.String `tfsdk:"operator"`
+	Value    types.String `tfsdk:"value"`
+}
+
+func (r *PolicyConditionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_policy_condition"
+}
+
+func (r *PolicyConditionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Policy condition",
+
+		Attributes: map[string]schema.Attribute{
+			"policy_id": schema.StringAttribute{
+				MarkdownDescription: "Policy UUID",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"subject": schema.StringAttribute{
+				MarkdownDescription: "Subject of the condition. Possible values: [" + strings.Join(subjects(), ", ") + "]",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(subjects()...),
+				},
+			},
+			"operator": schema.StringAttribute{
+				MarkdownDescription: "Operator of the condition. The operators supported depend on the subject, e.g. LICENSE_GROUP only supports IS and IS_NOT. " +
+					"Possible values: [" + strings.Join(operators(), ", ") + "]",
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(operators()...),
+				},
+			},
+			"value": schema.StringAttribute{
+				MarkdownDescription: "Value of the condition, e.g. a license group UUID for LICENSE_GROUP, a severity for SEVERITY or a regular expression for MATCHES",
+				Required:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Condition UUID",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *PolicyConditionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config PolicyConditionResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Subject.IsUnknown() || config.Operator.IsUnknown() || config.Value.IsUnknown() {
+		return
+	}
+
+	attribute, err := validateCondition(config.Subject.ValueString(), config.Operator.ValueString(), config.Value.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(attribute), "Invalid Policy Condition", fmt.Sprintf("Invalid policy condition: %s.", err))
+	}
+}
+
+func (r *PolicyConditionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan PolicyConditionResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policyID, policyIDDiags := utils.ParseAttributeUUID(plan.PolicyID.ValueString(), "policy_id")
+	resp.Diagnostics.Append(policyIDDiags...)
+
+	dtCondition, diags := TFConditionToDTCondition(plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	respCondition, err := r.Client.PolicyCondition.Create(ctx, policyID, dtCondition)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create policy condition, got error: %s", err))
+		return
+	}
+
+	plan = DTConditionToTFCondition(policyID, respCondition)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *PolicyConditionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state PolicyConditionResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policyID, policyIDDiags := utils.ParseAttributeUUID(state.PolicyID.ValueString(), "policy_id")
+	resp.Diagnostics.Append(policyIDDiags...)
+
+	conditionID, conditionIDDiags := utils.ParseAttributeUUID(state.ID.ValueString(), "id")
+	resp.Diagnostics.Append(conditionIDDiags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// There is no endpoint for getting a single condition, so it is looked up from the policy
+	policy, err := r.Client.Policy.Get(ctx, policyID)
+	if err != nil {
+		var apiErr *dtrack.APIError
+		if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read policy condition, got error: %s", err))
+		return
+	}
+
+	found := false
+	for _, condition := range policy.PolicyConditions {
+		if condition.UUID == conditionID {
+			found = true
+			state = DTConditionToTFCondition(policyID, condition)
+			break
+		}
+	}
+
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *PolicyConditionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state PolicyConditionResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policyID, policyIDDiags := utils.ParseAttributeUUID(state.PolicyID.ValueString(), "policy_id")
+	resp.Diagnostics.Append(policyIDDiags...)
+
+	dtCondition, diags := TFConditionToDTCondition(plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	respCondition, err := r.Client.PolicyCondition.Update(ctx, dtCondition)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update policy condition, got error: %s", err))
+		return
+	}
+
+	state = DTConditionToTFCondition(policyID, respCondition)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *PolicyConditionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state PolicyConditionResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conditionID, conditionIDDiags := utils.ParseAttributeUUID(state.ID.ValueString(), "id")
+	resp.Diagnostics.Append(conditionIDDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.Client.PolicyCondition.Delete(ctx, conditionID)
+	if err != nil {
+		var apiErr *dtrack.APIError
+		if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete policy condition, got error: %s", err))
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// ImportState imports a condition by its UUID. The policy of the condition is looked up from all policies.
+func (r *PolicyConditionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	conditionID, conditionIDDiags := utils.ParseUUID(req.ID)
+	resp.Diagnostics.Append(conditionIDDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policies, err := dtrack.FetchAll(func(po dtrack.PageOptions) (dtrack.Page[dtrack.Policy], error) {
+		return r.Client.Policy.GetAll(ctx, po)
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get policies, got error: %s", err))
+		return
+	}
+
+	for _, policy := range policies {
+		for _, condition := range policy.PolicyConditions {
+			if condition.UUID == conditionID {
+				resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), conditionID.String())...)
+				resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("policy_id"), policy.UUID.String())...)
+				return
+			}
+		}
+	}
+
+	resp.Diagnostics.AddError("Cannot import non-existent remote object", fmt.Sprintf("No policy has a condition with ID [%s]", req.ID))
+}
+
+func DTConditionToTFCondition(policyID uuid.UUID, dtCondition dtrack.PolicyCondition) PolicyConditionResourceModel {
+	return PolicyConditionResourceModel{
+		ID:       types.StringValue(dtCondition.UUID.String()),
+		PolicyID: types.StringValue(policyID.String()),
+		Subject:  types.StringValue(string(dtCondition.Subject)),
+		Operator: types.StringValue(string(dtCondition.Operator)),
+		Value:    types.StringValue(dtCondition.Value),
+	}
+}
+
+func TFConditionToDTCondition(tfCondition PolicyConditionResourceModel) (dtrack.PolicyCondition, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	condition := dtrack.PolicyCondition{
+		Subject:  dtrack.PolicyConditionSubject(tfCondition.Subject.ValueString()),
+		Operator: dtrack.PolicyConditionOperator(tfCondition.Operator.ValueString()),
+		Value:    tfCondition.Value.ValueString(),
+	}
+
+	if tfCondition.ID.IsUnknown() {
+		condition.UUID = uuid.Nil
+	} else {
+		conditionID, conditionIDDiags := utils.ParseAttributeUUID(tfCondition.ID.ValueString(), "id")
+		diags.Append(conditionIDDiags...)
+
+		condition.UUID = conditionID
+	}
+
+	return condition, diags
+}
@@ -0,0 +1,139 @@
+// Copyright (c) 2024 Futurice Oy
+// SPDX-License-Identifier: MPL-2.0
+
+package policycondition_test
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	dtrack "github.com/futurice/dependency-track-client-go"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/testutils"
+	policytestutils "github.com/futurice/terraform-provider-dependencytrack/internal/testutils/policy"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+var testDependencyTrack *testutils.TestDependencyTrack
+
+func TestMain(m *testing.M) {
+	if os.Getenv(resource.EnvTfAcc) != "" {
+		var cleanup func()
+		testDependencyTrack, cleanup = testutils.InitTestDependencyTrack()
+		defer cleanup()
+	}
+
+	m.Run()
+}
+
+func TestAccPolicyConditionResource_basic(t *testing.T) {
+	ctx := testutils.CreateTestContext(t)
+
+	policyName := acctest.RandomWithPrefix("test-policy")
+	policyResourceName := policytestutils.CreatePolicyResourceName("test")
+	conditionResourceName := policytestutils.CreatePolicyConditionResourceName("test")
+
+	var policyID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyConditionConfigBasic(testDependencyTrack, policyName, "SEVERITY", "IS", "CRITICAL"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testutils.TestAccCheckGetResourceID(policyResourceName, &policyID),
+					policytestutils.TestAccCheckPolicyHasExpectedConditions(ctx, testDependencyTrack, policyResourceName, []dtrack.PolicyCondition{
+						{Subject: "SEVERITY", Operator: "IS", Value: "CRITICAL"},
+					}),
+					resource.TestCheckResourceAttrSet(conditionResourceName, "id"),
+					resource.TestCheckResourceAttrPtr(conditionResourceName, "policy_id", &policyID),
+					resource.TestCheckResourceAttr(conditionResourceName, "subject", "SEVERITY"),
+					resource.TestCheckResourceAttr(conditionResourceName, "operator", "IS"),
+					resource.TestCheckResourceAttr(conditionResourceName, "value", "CRITICAL"),
+				),
+			},
+			{
+				ResourceName:      conditionResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPolicyConditionConfigBasic(testDependencyTrack, policyName, "PACKAGE_URL", "MATCHES", "pkg:npm/.*"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					policytestutils.TestAccCheckPolicyHasExpectedConditions(ctx, testDependencyTrack, policyResourceName, []dtrack.PolicyCondition{
+						{Subject: "PACKAGE_URL", Operator: "MATCHES", Value: "pkg:npm/.*"},
+					}),
+					resource.TestCheckResourceAttr(conditionResourceName, "subject", "PACKAGE_URL"),
+					resource.TestCheckResourceAttr(conditionResourceName, "operator", "MATCHES"),
+					resource.TestCheckResourceAttr(conditionResourceName, "value", "pkg:npm/.*"),
+				),
+			},
+			{
+				Config: testAccPolicyConditionConfigNoCondition(testDependencyTrack, policyName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					policytestutils.TestAccCheckPolicyHasExpectedConditions(ctx, testDependencyTrack, policyResourceName, []dtrack.PolicyCondition{}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPolicyConditionResource_invalid(t *testing.T) {
+	policyName := acctest.RandomWithPrefix("test-policy")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPolicyConditionConfigBasic(testDependencyTrack, policyName, "LICENSE_GROUP", "MATCHES", "8ffb30fb-77e6-4886-9f32-ff142f9bf90b"),
+				ExpectError: regexp.MustCompile(`operator MATCHES is not supported for subject LICENSE_GROUP`),
+			},
+			{
+				Config:      testAccPolicyConditionConfigBasic(testDependencyTrack, policyName, "LICENSE_GROUP", "IS", "Copyleft"),
+				ExpectError: regexp.MustCompile(`must be the UUID of a license group`),
+			},
+			{
+				Config:      testAccPolicyConditionConfigBasic(testDependencyTrack, policyName, "SEVERITY", "IS", "SEVERE"),
+				ExpectError: regexp.MustCompile(`Invalid Policy Condition`),
+			},
+			{
+				Config:      testAccPolicyConditionConfigBasic(testDependencyTrack, policyName, "LICENCE", "IS", "MIT"),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Value Match`),
+			},
+		},
+	})
+}
+
+func testAccPolicyConditionConfigNoCondition(testDependencyTrack *testutils.TestDependencyTrack, policyName string) string {
+	return testDependencyTrack.AddProviderConfiguration(
+		fmt.Sprintf(`
+resource "dependencytrack_policy" "test" {
+	name            = %[1]q
+	operator        = "ANY"
+	violation_state = "FAIL"
+}
+`,
+			policyName,
+		),
+	)
+}
+
+func testAccPolicyConditionConfigBasic(testDependencyTrack *testutils.TestDependencyTrack, policyName, subject, operator, value string) string {
+	return testutils.ComposeConfigs(
+		testAccPolicyConditionConfigNoCondition(testDependencyTrack, policyName),
+		fmt.Sprintf(`
+resource "dependencytrack_policy_condition" "test" {
+	policy_id = dependencytrack_policy.test.id
+	subject   = %[1]q
+	operator  = %[2]q
+	value     = %[3]q
+}
+`,
+			subject, operator, value,
+		),
+	)
+}
@@ -0,0 +1,89 @@
+// Copyright (c) 2024 Futurice Oy
+// SPDX-License-Identifier: MPL-2.0
+
+package policycondition
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+var (
+	identityOperators = []string{"IS", "IS_NOT"}
+	matchOperators    = []string{"MATCHES", "NO_MATCH"}
+	numericOperators  = []string{
+		"NUMERIC_GREATER_THAN", "NUMERIC_LESS_THAN", "NUMERIC_EQUAL", "NUMERIC_NOT_EQUAL",
+		"NUMERIC_GREATER_THAN_OR_EQUAL", "NUMERIC_LESSER_THAN_OR_EQUAL",
+	}
+	containsOperators = []string{"CONTAINS_ANY", "CONTAINS_ALL"}
+)
+
+// subjectOperators are the operators Dependency-Track supports for each subject of a policy condition.
+var subjectOperators = map[string][]string{
+	"AGE":              numericOperators,
+	"COORDINATES":      matchOperators,
+	"CPE":              matchOperators,
+	"LICENSE":          identityOperators,
+	"LICENSE_GROUP":    identityOperators,
+	"PACKAGE_URL":      matchOperators,
+	"SEVERITY":         identityOperators,
+	"SWID_TAGID":       matchOperators,
+	"VERSION":          numericOperators,
+	"COMPONENT_HASH":   identityOperators,
+	"CWE":              containsOperators,
+	"VULNERABILITY_ID": identityOperators,
+	"VERSION_DISTANCE": numericOperators,
+	"EPSS":             numericOperators,
+}
+
+var severities = []string{"CRITICAL", "HIGH", "MEDIUM", "LOW", "INFO", "UNASSIGNED"}
+
+func subjects() []string {
+	subjects := make([]string, 0, len(subjectOperators))
+	for subject := range subjectOperators {
+		subjects = append(subjects, subject)
+	}
+	sort.Strings(subjects)
+
+	return subjects
+}
+
+func operators() []string {
+	var operators []string
+	operators = append(operators, identityOperators...)
+	operators = append(operators, matchOperators...)
+	operators = append(operators, numericOperators...)
+	operators = append(operators, containsOperators...)
+
+	return operators
+}
+
+// validateCondition returns an error if the server would reject the combination of subject, operator and value,
+// together with the name of the attribute the error is about.
+func validateCondition(subject, operator, value string) (string, error) {
+	allowedOperators, ok := subjectOperators[subject]
+	if !ok {
+		return "", nil // unknown subjects are reported by the validator of the subject attribute
+	}
+
+	if !slices.Contains(allowedOperators, operator) {
+		return "operator", fmt.Errorf("operator %s is not supported for subject %s, expected one of [%s]", operator, subject, strings.Join(allowedOperators, ", "))
+	}
+
+	switch subject {
+	case "LICENSE_GROUP":
+		if _, err := uuid.Parse(value); err != nil {
+			return "value", fmt.Errorf("the value of a %s condition must be the UUID of a license group, got [%s]", subject, value)
+		}
+	case "SEVERITY":
+		if !slices.Contains(severities, value) {
+			return "value", fmt.Errorf("the value of a %s condition must be one of [%s], got [%s]", subject, strings.Join(severities, ", "), value)
+		}
+	}
+
+	return "", nil
+}
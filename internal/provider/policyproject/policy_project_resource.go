@@ -0,0 +1,198 @@
+// Copyright (c) 2024 Futurice Oy
+// SPDX-License-Identifier: MPL-2.0
+
+package policyproject
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	dtrack "github.com/futurice/dependency-track-client-go"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/providerdata"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/utils"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &PolicyProjectResource{}
+var _ resource.ResourceWithImportState = &PolicyProjectResource{}
+
+func NewPolicyProjectResource() resource.Resource {
+	return &PolicyProjectResource{}
+}
+
+// PolicyProjectResource defines the resource implementation.
+type PolicyProjectResource struct {
+	providerdata.ResourceBase
+}
+
+// PolicyProjectResourceModel describes the resource data model.
+type PolicyProjectResourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	PolicyID  types.String `tfsdk:"policy_id"`
+	ProjectID types.String `tfsdk:"project_id"`
+}
+
+func (r *PolicyProjectResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_policy_project"
+}
+
+func (r *PolicyProjectResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Policy project. Limits the policy to the project, instead of applying it to all projects.",
+
+		Attributes: map[string]schema.Attribute{
+			"policy_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the policy",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the project",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Synthetic policy project ID in the form of policy_id/project_id",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *PolicyProjectResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan, state PolicyProjectResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policyID, policyIDDiags := utils.ParseAttributeUUID(plan.PolicyID.ValueString(), "policy_id")
+	resp.Diagnostics.Append(policyIDDiags...)
+
+	projectID, projectIDDiags := utils.ParseAttributeUUID(plan.ProjectID.ValueString(), "project_id")
+	resp.Diagnostics.Append(projectIDDiags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.Client.Policy.AddProject(ctx, policyID, projectID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create policy project relation, got error: %s", err))
+		return
+	}
+
+	state.ID = types.StringValue(makePolicyProjectID(policyID, projectID))
+	state.PolicyID = types.StringValue(policyID.String())
+	state.ProjectID = types.StringValue(projectID.String())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *PolicyProjectResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state PolicyProjectResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policyID, policyIDDiags := utils.ParseAttributeUUID(state.PolicyID.ValueString(), "policy_id")
+	resp.Diagnostics.Append(policyIDDiags...)
+
+	projectID, projectIDDiags := utils.ParseAttributeUUID(state.ProjectID.ValueString(), "project_id")
+	resp.Diagnostics.Append(projectIDDiags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policy, err := r.Client.Policy.Get(ctx, policyID)
+	if err != nil {
+		var apiErr *dtrack.APIError
+		if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read policy, got error: %s", err))
+		return
+	}
+
+	found := false
+	for _, project := range policy.Projects {
+		if project.UUID == projectID {
+			found = true
+			break
+		}
+	}
+
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *PolicyProjectResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.AddError("Internal Error", "Policy project relation resource is immutable")
+}
+
+func (r *PolicyProjectResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state PolicyProjectResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policyID, policyIDDiags := utils.ParseAttributeUUID(state.PolicyID.ValueString(), "policy_id")
+	resp.Diagnostics.Append(policyIDDiags...)
+
+	projectID, projectIDDiags := utils.ParseAttributeUUID(state.ProjectID.ValueString(), "project_id")
+	resp.Diagnostics.Append(projectIDDiags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.Client.Policy.DeleteProject(ctx, policyID, projectID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete policy project relation, got error: %s", err))
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *PolicyProjectResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 2 {
+		resp.Diagnostics.AddError("Invalid import ID", fmt.Sprintf("Expected ID in the format 'policy_id/project_id', got [%s]", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("policy_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), parts[1])...)
+}
+
+func makePolicyProjectID(policyID uuid.UUID, projectID uuid.UUID) string {
+	return fmt.Sprintf("%s/%s", policyID.String(), projectID.String())
+}
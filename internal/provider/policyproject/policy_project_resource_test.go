@@ -0,0 +1,183 @@
+// Copyright (c) 2024 Futurice Oy
+// SPDX-License-Identifier: MPL-2.0
+
+package policyproject_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/futurice/terraform-provider-dependencytrack/internal/testutils"
+	policytestutils "github.com/futurice/terraform-provider-dependencytrack/internal/testutils/policy"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/testutils/projecttestutils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+var testDependencyTrack *testutils.TestDependencyTrack
+
+func TestMain(m *testing.M) {
+	if os.Getenv(resource.EnvTfAcc) != "" {
+		var cleanup func()
+		testDependencyTrack, cleanup = testutils.InitTestDependencyTrack()
+		defer cleanup()
+	}
+
+	m.Run()
+}
+
+func TestAccPolicyProjectResource_basic(t *testing.T) {
+	ctx := testutils.CreateTestContext(t)
+
+	policyName := acctest.RandomWithPrefix("test-policy")
+	projectName := acctest.RandomWithPrefix("test-project")
+
+	policyResourceName := policytestutils.CreatePolicyResourceName("test")
+	otherPolicyResourceName := policytestutils.CreatePolicyResourceName("test-other")
+
+	projectResourceName := projecttestutils.CreateProjectResourceName("test")
+	otherProjectResourceName := projecttestutils.CreateProjectResourceName("test-other")
+
+	policyProjectResourceName := policytestutils.CreatePolicyProjectResourceName("test")
+
+	var policyID, projectID, otherPolicyID, otherProjectID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyProjectConfigBasic(testDependencyTrack, policyName, projectName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testutils.TestAccCheckGetResourceID(policyResourceName, &policyID),
+					testutils.TestAccCheckGetResourceID(projectResourceName, &projectID),
+					policytestutils.TestAccCheckPolicyHasExpectedProjects(ctx, testDependencyTrack, policyResourceName, []*string{&projectID}),
+					resource.TestCheckResourceAttrPtr(policyProjectResourceName, "policy_id", &policyID),
+					resource.TestCheckResourceAttrPtr(policyProjectResourceName, "project_id", &projectID),
+				),
+			},
+			{
+				ResourceName:      policyProjectResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPolicyProjectConfigOtherPolicyAndProject(testDependencyTrack, policyName, projectName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testutils.TestAccCheckGetResourceID(otherPolicyResourceName, &otherPolicyID),
+					testutils.TestAccCheckGetResourceID(otherProjectResourceName, &otherProjectID),
+					policytestutils.TestAccCheckPolicyHasExpectedProjects(ctx, testDependencyTrack, policyResourceName, []*string{}),
+					policytestutils.TestAccCheckPolicyHasExpectedProjects(ctx, testDependencyTrack, otherPolicyResourceName, []*string{&otherProjectID}),
+					resource.TestCheckResourceAttrPtr(policyProjectResourceName, "policy_id", &otherPolicyID),
+					resource.TestCheckResourceAttrPtr(policyProjectResourceName, "project_id", &otherProjectID),
+				),
+			},
+			{
+				Config: testAccPolicyProjectConfigNoProject(testDependencyTrack, policyName, projectName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					policytestutils.TestAccCheckPolicyHasExpectedProjects(ctx, testDependencyTrack, policyResourceName, []*string{}),
+					policytestutils.TestAccCheckPolicyHasExpectedProjects(ctx, testDependencyTrack, otherPolicyResourceName, []*string{}),
+				),
+			},
+		},
+		// CheckDestroy is not practical here since the policy is destroyed as well, and we can no longer query its projects
+	})
+}
+
+func TestAccPolicyProjectResource_disappears(t *testing.T) {
+	ctx := testutils.CreateTestContext(t)
+
+	policyName := acctest.RandomWithPrefix("test-policy")
+	projectName := acctest.RandomWithPrefix("test-project")
+
+	policyResourceName := policytestutils.CreatePolicyResourceName("test")
+	projectResourceName := projecttestutils.CreateProjectResourceName("test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyProjectConfigBasic(testDependencyTrack, policyName, projectName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccPolicyProjectDeleteOutsideTerraform(ctx, policyResourceName, projectResourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccPolicyProjectDeleteOutsideTerraform(ctx context.Context, policyResourceName, projectResourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		policyID, err := testutils.GetResourceID(state, policyResourceName)
+		if err != nil {
+			return err
+		}
+
+		projectID, err := testutils.GetResourceID(state, projectResourceName)
+		if err != nil {
+			return err
+		}
+
+		_, err = testDependencyTrack.Client.Policy.DeleteProject(ctx, policyID, projectID)
+		if err != nil {
+			return fmt.Errorf("failed to remove project %s from policy %s: %w", projectID, policyID, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccPolicyProjectConfigPolicyAndProject(localName, policyName, projectName string) string {
+	return fmt.Sprintf(`
+resource "dependencytrack_policy" %[1]q {
+	name            = %[2]q
+	operator        = "ANY"
+	violation_state = "FAIL"
+}
+
+resource "dependencytrack_project" %[1]q {
+	name        = %[3]q
+	classifier  = "APPLICATION"
+}
+`,
+		localName, policyName, projectName,
+	)
+}
+
+func testAccPolicyProjectConfigNoProject(testDependencyTrack *testutils.TestDependencyTrack, policyName, projectName string) string {
+	return testDependencyTrack.AddProviderConfiguration(
+		testutils.ComposeConfigs(
+			testAccPolicyProjectConfigPolicyAndProject("test", policyName, projectName),
+			testAccPolicyProjectConfigPolicyAndProject("test-other", policyName+"-other", projectName+"-other"),
+		),
+	)
+}
+
+func testAccPolicyProjectConfigBasic(testDependencyTrack *testutils.TestDependencyTrack, policyName, projectName string) string {
+	return testutils.ComposeConfigs(
+		testAccPolicyProjectConfigNoProject(testDependencyTrack, policyName, projectName),
+		`
+resource "dependencytrack_policy_project" "test" {
+	policy_id  = dependencytrack_policy.test.id
+	project_id = dependencytrack_project.test.id
+}
+`,
+	)
+}
+
+func testAccPolicyProjectConfigOtherPolicyAndProject(testDependencyTrack *testutils.TestDependencyTrack, policyName, projectName string) string {
+	return testutils.ComposeConfigs(
+		testAccPolicyProjectConfigNoProject(testDependencyTrack, policyName, projectName),
+		`
+resource "dependencytrack_policy_project" "test" {
+	policy_id  = dependencytrack_policy.test-other.id
+	project_id = dependencytrack_project.test-other.id
+}
+`,
+	)
+}
@@ -0,0 +1,197 @@
+// Copyright (c) 2024 Futurice Oy
+// SPDX-License-Identifier: MPL-2.0
+
+package policytag
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	dtrack "github.com/futurice/dependency-track-client-go"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/providerdata"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/utils"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &PolicyTagResource{}
+var _ resource.ResourceWithImportState = &PolicyTagResource{}
+
+func NewPolicyTagResource() resource.Resource {
+	return &PolicyTagResource{}
+}
+
+// PolicyTagResource defines the resource implementation.
+type PolicyTagResource struct {
+	providerdata.ResourceBase
+}
+
+// PolicyTagResourceModel describes the resource data model.
+type PolicyTagResourceModel struct {
+	ID       types.String `tfsdk:"id"`
+	PolicyID types.String `tfsdk:"policy_id"`
+	Tag      types.String `tfsdk:"tag"`
+}
+
+func (r *PolicyTagResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_policy_tag"
+}
+
+func (r *PolicyTagResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Policy tag. Limits the policy to the projects with the tag, instead of applying it to all projects.",
+
+		Attributes: map[string]schema.Attribute{
+			"policy_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the policy",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"tag": schema.StringAttribute{
+				MarkdownDescription: "Name of the tag",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Synthetic policy tag ID in the form of policy_id/tag",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *PolicyTagResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan, state PolicyTagResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policyID, policyIDDiags := utils.ParseAttributeUUID(plan.PolicyID.ValueString(), "policy_id")
+	resp.Diagnostics.Append(policyIDDiags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tag := plan.Tag.ValueString()
+
+	_, err := r.Client.Policy.AddTag(ctx, policyID, tag)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create policy tag relation, got error: %s", err))
+		return
+	}
+
+	state.ID = types.StringValue(makePolicyTagID(policyID, tag))
+	state.PolicyID = types.StringValue(policyID.String())
+	state.Tag = types.StringValue(tag)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *PolicyTagResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state PolicyTagResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policyID, policyIDDiags := utils.ParseAttributeUUID(state.PolicyID.ValueString(), "policy_id")
+	resp.Diagnostics.Append(policyIDDiags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policy, err := r.Client.Policy.Get(ctx, policyID)
+	if err != nil {
+		var apiErr *dtrack.APIError
+		if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read policy, got error: %s", err))
+		return
+	}
+
+	found := false
+	for _, tag := range policy.Tags {
+		// Dependency-Track may normalize the case of tag names
+		if strings.EqualFold(tag.Name, state.Tag.ValueString()) {
+			found = true
+			break
+		}
+	}
+
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *PolicyTagResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.AddError("Internal Error", "Policy tag relation resource is immutable")
+}
+
+func (r *PolicyTagResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state PolicyTagResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policyID, policyIDDiags := utils.ParseAttributeUUID(state.PolicyID.ValueString(), "policy_id")
+	resp.Diagnostics.Append(policyIDDiags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.Client.Policy.DeleteTag(ctx, policyID, state.Tag.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete policy tag relation, got error: %s", err))
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *PolicyTagResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.SplitN(req.ID, "/", 2)
+	if len(parts) != 2 || parts[1] == "" {
+		resp.Diagnostics.AddError("Invalid import ID", fmt.Sprintf("Expected ID in the format 'policy_id/tag', got [%s]", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("policy_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("tag"), parts[1])...)
+}
+
+func makePolicyTagID(policyID uuid.UUID, tag string) string {
+	return fmt.Sprintf("%s/%s", policyID.String(), tag)
+}
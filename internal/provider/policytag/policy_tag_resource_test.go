@@ -0,0 +1,140 @@
+// Copyright (c) 2024 Futurice Oy
+// SPDX-License-Identifier: MPL-2.0
+
+package policytag_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/futurice/terraform-provider-dependencytrack/internal/testutils"
+	policytestutils "github.com/futurice/terraform-provider-dependencytrack/internal/testutils/policy"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+var testDependencyTrack *testutils.TestDependencyTrack
+
+func TestMain(m *testing.M) {
+	if os.Getenv(resource.EnvTfAcc) != "" {
+		var cleanup func()
+		testDependencyTrack, cleanup = testutils.InitTestDependencyTrack()
+		defer cleanup()
+	}
+
+	m.Run()
+}
+
+func TestAccPolicyTagResource_basic(t *testing.T) {
+	ctx := testutils.CreateTestContext(t)
+
+	policyName := acctest.RandomWithPrefix("test-policy")
+
+	policyResourceName := policytestutils.CreatePolicyResourceName("test")
+	policyTagResourceName := policytestutils.CreatePolicyTagResourceName("test")
+
+	var policyID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyTagConfigBasic(testDependencyTrack, policyName, "regulated"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testutils.TestAccCheckGetResourceID(policyResourceName, &policyID),
+					policytestutils.TestAccCheckPolicyHasExpectedTags(ctx, testDependencyTrack, policyResourceName, []string{"regulated"}),
+					resource.TestCheckResourceAttrPtr(policyTagResourceName, "policy_id", &policyID),
+					resource.TestCheckResourceAttr(policyTagResourceName, "tag", "regulated"),
+				),
+			},
+			{
+				ResourceName:      policyTagResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPolicyTagConfigBasic(testDependencyTrack, policyName, "medical"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					policytestutils.TestAccCheckPolicyHasExpectedTags(ctx, testDependencyTrack, policyResourceName, []string{"medical"}),
+					resource.TestCheckResourceAttr(policyTagResourceName, "tag", "medical"),
+				),
+			},
+			{
+				Config: testAccPolicyTagConfigNoTag(testDependencyTrack, policyName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					policytestutils.TestAccCheckPolicyHasExpectedTags(ctx, testDependencyTrack, policyResourceName, []string{}),
+				),
+			},
+		},
+		// CheckDestroy is not practical here since the policy is destroyed as well, and we can no longer query its tags
+	})
+}
+
+func TestAccPolicyTagResource_disappears(t *testing.T) {
+	ctx := testutils.CreateTestContext(t)
+
+	policyName := acctest.RandomWithPrefix("test-policy")
+	policyResourceName := policytestutils.CreatePolicyResourceName("test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyTagConfigBasic(testDependencyTrack, policyName, "regulated"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccPolicyTagDeleteOutsideTerraform(ctx, policyResourceName, "regulated"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccPolicyTagDeleteOutsideTerraform(ctx context.Context, policyResourceName, tag string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		policyID, err := testutils.GetResourceID(state, policyResourceName)
+		if err != nil {
+			return err
+		}
+
+		_, err = testDependencyTrack.Client.Policy.DeleteTag(ctx, policyID, tag)
+		if err != nil {
+			return fmt.Errorf("failed to remove tag %s from policy %s: %w", tag, policyID, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccPolicyTagConfigNoTag(testDependencyTrack *testutils.TestDependencyTrack, policyName string) string {
+	return testDependencyTrack.AddProviderConfiguration(
+		fmt.Sprintf(`
+resource "dependencytrack_policy" "test" {
+	name            = %[1]q
+	operator        = "ANY"
+	violation_state = "FAIL"
+}
+`,
+			policyName,
+		),
+	)
+}
+
+func testAccPolicyTagConfigBasic(testDependencyTrack *testutils.TestDependencyTrack, policyName, tag string) string {
+	return testutils.ComposeConfigs(
+		testAccPolicyTagConfigNoTag(testDependencyTrack, policyName),
+		fmt.Sprintf(`
+resource "dependencytrack_policy_tag" "test" {
+	policy_id = dependencytrack_policy.test.id
+	tag       = %[1]q
+}
+`,
+			tag,
+		),
+	)
+}
@@ -0,0 +1,165 @@
+// Copyright (c) 2024 Futurice Oy
+// SPDX-License-Identifier: MPL-2.0
+
+package policyviolation
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	dtrack "github.com/futurice/dependency-track-client-go"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/providerdata"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &PolicyViolationDataSource{}
+
+var violationTypes = []string{"LICENSE", "SECURITY", "OPERATIONAL"}
+
+func NewPolicyViolationDataSource() datasource.DataSource {
+	return &PolicyViolationDataSource{}
+}
+
+// PolicyViolationDataSource defines the data source implementation.
+type PolicyViolationDataSource struct {
+	providerdata.DataSourceBase
+}
+
+// PolicyViolationDataSourceModel describes the data source data model.
+type PolicyViolationDataSourceModel struct {
+	ProjectID         types.String `tfsdk:"project_id"`
+	ComponentPURL     types.String `tfsdk:"component_purl"`
+	PolicyName        types.String `tfsdk:"policy_name"`
+	Type              types.String `tfsdk:"type"`
+	ID                types.String `tfsdk:"id"`
+	ComponentID       types.String `tfsdk:"component_id"`
+	PolicyConditionID types.String `tfsdk:"policy_condition_id"`
+	Text              types.String `tfsdk:"text"`
+}
+
+func (d *PolicyViolationDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_policy_violation"
+}
+
+func (d *PolicyViolationDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Looks up a policy violation of a project by the PURL of the violating component and the name of " +
+			"the violated policy, e.g. for `dependencytrack_violation_analysis`. Exactly one violation must match.",
+
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "Project UUID",
+				Required:            true,
+			},
+			"component_purl": schema.StringAttribute{
+				MarkdownDescription: "Package URL of the component violating the policy",
+				Required:            true,
+			},
+			"policy_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the violated policy",
+				Required:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Type of the violation, used to tell apart violations of different conditions of the " +
+					"policy. Must be one of the following values: [" + strings.Join(violationTypes, ", ") + "]",
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(violationTypes...),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Policy violation UUID",
+				Computed:            true,
+			},
+			"component_id": schema.StringAttribute{
+				MarkdownDescription: "UUID of the component violating the policy",
+				Computed:            true,
+			},
+			"policy_condition_id": schema.StringAttribute{
+				MarkdownDescription: "UUID of the violated policy condition",
+				Computed:            true,
+			},
+			"text": schema.StringAttribute{
+				MarkdownDescription: "Description of the violation",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *PolicyViolationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var model PolicyViolationDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID, projectIDDiags := utils.ParseAttributeUUID(model.ProjectID.ValueString(), "project_id")
+	resp.Diagnostics.Append(projectIDDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var matches []dtrack.PolicyViolation
+	err := dtrack.ForEach(
+		func(po dtrack.PageOptions) (dtrack.Page[dtrack.PolicyViolation], error) {
+			// Suppressed violations are included, as they may have been suppressed by the analysis looked up for
+			return d.Client.PolicyViolation.GetAllForProject(ctx, projectID, true, po)
+		},
+		func(violation dtrack.PolicyViolation) error {
+			if violationMatches(violation, model) {
+				matches = append(matches, violation)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read policy violations, got error: %s", err))
+		return
+	}
+
+	switch len(matches) {
+	case 0:
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("The component %s of the project does not violate the policy %s", model.ComponentPURL.ValueString(), model.PolicyName.ValueString()))
+		return
+	case 1:
+	default:
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("The component %s violates %d conditions of the policy %s, set type to choose the violation",
+				model.ComponentPURL.ValueString(), len(matches), model.PolicyName.ValueString()))
+		return
+	}
+
+	violation := matches[0]
+
+	model.ID = types.StringValue(violation.UUID.String())
+	model.ComponentID = types.StringValue(violation.Component.UUID.String())
+	model.PolicyConditionID = types.StringValue(violation.PolicyCondition.UUID.String())
+	model.Type = types.StringValue(violation.Type)
+	model.Text = types.StringValue(violation.Text)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func violationMatches(violation dtrack.PolicyViolation, model PolicyViolationDataSourceModel) bool {
+	if violation.PolicyCondition == nil || violation.PolicyCondition.Policy == nil {
+		return false
+	}
+
+	if !model.Type.IsNull() && violation.Type != model.Type.ValueString() {
+		return false
+	}
+
+	return violation.Component.PURL == model.ComponentPURL.ValueString() &&
+		violation.PolicyCondition.Policy.Name == model.PolicyName.ValueString()
+}
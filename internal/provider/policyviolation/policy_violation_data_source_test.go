@@ -0,0 +1,105 @@
+// Copyright (c) 2024 Futurice Oy
+// SPDX-License-Identifier: MPL-2.0
+
+package policyviolation_test
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/futurice/terraform-provider-dependencytrack/internal/testutils"
+	violationtestutils "github.com/futurice/terraform-provider-dependencytrack/internal/testutils/violation"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+var testDependencyTrack *testutils.TestDependencyTrack
+
+func TestMain(m *testing.M) {
+	if os.Getenv(resource.EnvTfAcc) != "" {
+		var cleanup func()
+		testDependencyTrack, cleanup = testutils.InitTestDependencyTrack()
+		defer cleanup()
+	}
+
+	m.Run()
+}
+
+func TestAccPolicyViolationDataSource_basic(t *testing.T) {
+	ctx := testutils.CreateTestContext(t)
+
+	violation := violationtestutils.CreateTestPolicyViolation(ctx, t, testDependencyTrack)
+	violationDataSourceName := violationtestutils.CreatePolicyViolationDataSourceName("test")
+	analysisResourceName := violationtestutils.CreateViolationAnalysisResourceName("test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyViolationDataSourceConfigBasic(testDependencyTrack, violation),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(violationDataSourceName, "id", violation.ViolationID.String()),
+					resource.TestCheckResourceAttr(violationDataSourceName, "component_id", violation.ComponentID.String()),
+					resource.TestCheckResourceAttr(violationDataSourceName, "type", "OPERATIONAL"),
+					resource.TestCheckResourceAttrSet(violationDataSourceName, "policy_condition_id"),
+					violationtestutils.TestAccCheckViolationAnalysisHasExpectedData(ctx, testDependencyTrack, violation, "APPROVED", true),
+					resource.TestCheckResourceAttrPair(analysisResourceName, "policy_violation_id", violationDataSourceName, "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPolicyViolationDataSource_notFound(t *testing.T) {
+	ctx := testutils.CreateTestContext(t)
+
+	violation := violationtestutils.CreateTestPolicyViolation(ctx, t, testDependencyTrack)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPolicyViolationDataSourceConfigNotFound(testDependencyTrack, violation),
+				ExpectError: regexp.MustCompile("does not violate the policy"),
+			},
+		},
+	})
+}
+
+func testAccPolicyViolationDataSourceConfigBasic(testDependencyTrack *testutils.TestDependencyTrack, violation violationtestutils.Violation) string {
+	return testDependencyTrack.AddProviderConfiguration(
+		fmt.Sprintf(`
+data "dependencytrack_policy_violation" "test" {
+	project_id     = %[1]q
+	component_purl = %[2]q
+	policy_name    = %[3]q
+}
+
+resource "dependencytrack_violation_analysis" "test" {
+	component_id        = data.dependencytrack_policy_violation.test.component_id
+	policy_violation_id = data.dependencytrack_policy_violation.test.id
+	state               = "APPROVED"
+	suppressed          = true
+}
+`,
+			violation.ProjectID, violation.ComponentPURL, violation.PolicyName,
+		),
+	)
+}
+
+func testAccPolicyViolationDataSourceConfigNotFound(testDependencyTrack *testutils.TestDependencyTrack, violation violationtestutils.Violation) string {
+	return testDependencyTrack.AddProviderConfiguration(
+		fmt.Sprintf(`
+data "dependencytrack_policy_violation" "test" {
+	project_id     = %[1]q
+	component_purl = "pkg:generic/nonexistent@1.0.0"
+	policy_name    = %[2]q
+}
+`,
+			violation.ProjectID, violation.PolicyName,
+		),
+	)
+}
@@ -0,0 +1,154 @@
+// Copyright (c) 2024 Futurice Oy
+// SPDX-License-Identifier: MPL-2.0
+
+package project
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	dtrack "github.com/futurice/dependency-track-client-go"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// collectionMinimumVersion is the first version of Dependency-Track supporting collection projects.
+const collectionMinimumVersion = "4.13.0"
+
+const (
+	collectionLogicNone                  = "NONE"
+	collectionLogicDirectChildren        = "AGGREGATE_DIRECT_CHILDREN"
+	collectionLogicDirectChildrenWithTag = "AGGREGATE_DIRECT_CHILDREN_WITH_TAG"
+	collectionLogicLatestVersions        = "AGGREGATE_LATEST_VERSION_CHILDREN"
+)
+
+var collectionLogics = []string{
+	collectionLogicNone,
+	collectionLogicDirectChildren,
+	collectionLogicDirectChildrenWithTag,
+	collectionLogicLatestVersions,
+}
+
+// nonCollectionClassifiers are the classifiers of projects describing a single file or data set, which can not be
+// collection projects.
+var nonCollectionClassifiers = []string{
+	"DATA",
+	"FILE",
+}
+
+// validateCollection checks that collection_tag is set if and only if the collection logic uses it, and that the
+// classifier allows a collection project. Unknown values are skipped, since they are validated again once they are
+// known. Components are not part of the configuration, so they are checked against the server in
+// modifyCollectionPlan instead.
+func validateCollection(config ProjectResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if config.CollectionLogic.IsUnknown() || config.CollectionTag.IsUnknown() {
+		return diags
+	}
+
+	collectionLogic := config.CollectionLogic.ValueString()
+	if collectionLogic == "" {
+		collectionLogic = collectionLogicNone
+	}
+
+	switch {
+	case collectionLogic == collectionLogicDirectChildrenWithTag && config.CollectionTag.IsNull():
+		diags.AddAttributeError(
+			path.Root("collection_tag"),
+			"Missing Collection Tag",
+			fmt.Sprintf("The collection_tag attribute is required when collection_logic is %s.", collectionLogicDirectChildrenWithTag),
+		)
+	case collectionLogic != collectionLogicDirectChildrenWithTag && !config.CollectionTag.IsNull():
+		diags.AddAttributeError(
+			path.Root("collection_tag"),
+			"Invalid Collection Tag",
+			fmt.Sprintf("The collection_tag attribute can only be set when collection_logic is %s, got %s.", collectionLogicDirectChildrenWithTag, collectionLogic),
+		)
+	}
+
+	if collectionLogic != collectionLogicNone && !config.Classifier.IsUnknown() && slices.Contains(nonCollectionClassifiers, config.Classifier.ValueString()) {
+		diags.AddAttributeError(
+			path.Root("classifier"),
+			"Invalid Collection Classifier",
+			fmt.Sprintf("A project with the classifier %s can not be a collection project, got collection_logic %s.", config.Classifier.ValueString(), collectionLogic),
+		)
+	}
+
+	return diags
+}
+
+// modifyCollectionPlan rejects collection projects on servers which do not support them, and turning a project with
+// components into a collection project.
+func (r *ProjectResource) modifyCollectionPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var collectionLogic types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("collection_logic"), &collectionLogic)...)
+
+	if collectionLogic.IsUnknown() || collectionLogic.IsNull() || collectionLogic.ValueString() == collectionLogicNone {
+		return
+	}
+
+	resp.Diagnostics.Append(r.RequireServerVersion(path.Root("collection_logic"), "Collection projects", collectionMinimumVersion)...)
+
+	// new projects have no components, and existing collection projects have already been checked
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var priorCollectionLogic, projectIDString types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("collection_logic"), &priorCollectionLogic)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &projectIDString)...)
+	if resp.Diagnostics.HasError() || (!priorCollectionLogic.IsNull() && priorCollectionLogic.ValueString() != collectionLogicNone) {
+		return
+	}
+
+	projectID, projectIDDiags := utils.ParseAttributeUUID(projectIDString.ValueString(), "id")
+	resp.Diagnostics.Append(projectIDDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	components, err := r.Client.Component.GetAll(ctx, projectID, dtrack.PageOptions{PageNumber: 1, PageSize: 1}, dtrack.ComponentFilterOptions{})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get components of project, got error: %s", err))
+		return
+	}
+
+	if components.TotalCount > 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("collection_logic"),
+			"Collection Project With Components",
+			fmt.Sprintf("The project has %d components, but collection projects aggregate the metrics of their children and cannot have components. "+
+				"Remove the components, e.g. by uploading an empty BOM, before setting collection_logic.", components.TotalCount),
+		)
+	}
+}
+
+func DTCollectionToTF(dtProject dtrack.Project) (types.String, types.String) {
+	collectionLogic := types.StringValue(collectionLogicNone)
+	if dtProject.CollectionLogic != nil && *dtProject.CollectionLogic != "" {
+		collectionLogic = types.StringValue(string(*dtProject.CollectionLogic))
+	}
+
+	collectionTag := types.StringNull()
+	if dtProject.CollectionTag != nil && dtProject.CollectionTag.Name != "" {
+		collectionTag = types.StringValue(dtProject.CollectionTag.Name)
+	}
+
+	return collectionLogic, collectionTag
+}
+
+func TFCollectionToDT(tfProject ProjectResourceModel, project *dtrack.Project) {
+	if !tfProject.CollectionLogic.IsNull() && !tfProject.CollectionLogic.IsUnknown() {
+		collectionLogic := dtrack.CollectionLogic(tfProject.CollectionLogic.ValueString())
+		project.CollectionLogic = &collectionLogic
+	}
+
+	if !tfProject.CollectionTag.IsNull() && !tfProject.CollectionTag.IsUnknown() {
+		project.CollectionTag = &dtrack.Tag{Name: tfProject.CollectionTag.ValueString()}
+	}
+}
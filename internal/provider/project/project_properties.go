@@ -0,0 +1,189 @@
+// Copyright (c) 2024 Futurice Oy
+// SPDX-License-Identifier: MPL-2.0
+
+package project
+
+import (
+	"context"
+	"fmt"
+
+	dtrack "github.com/futurice/dependency-track-client-go"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/utils"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var projectPropertyAttrTypes = map[string]attr.Type{
+	"group":       types.StringType,
+	"name":        types.StringType,
+	"value":       types.StringType,
+	"type":        types.StringType,
+	"description": types.StringType,
+}
+
+// ProjectPropertyModel describes a property in the properties attribute of a project.
+type ProjectPropertyModel struct {
+	Group       types.String `tfsdk:"group"`
+	Name        types.String `tfsdk:"name"`
+	Value       types.String `tfsdk:"value"`
+	Type        types.String `tfsdk:"type"`
+	Description types.String `tfsdk:"description"`
+}
+
+func propertyKey(group, name string) string {
+	return group + "/" + name
+}
+
+// validateProperties checks that the values of the properties match their types.
+func validateProperties(ctx context.Context, properties types.Set) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if properties.IsNull() || properties.IsUnknown() {
+		return diags
+	}
+
+	var models []ProjectPropertyModel
+	diags.Append(properties.ElementsAs(ctx, &models, false)...)
+
+	for _, model := range models {
+		if model.Type.IsUnknown() || model.Value.IsUnknown() {
+			continue
+		}
+
+		if err := utils.ValidatePropertyValue(model.Type.ValueString(), model.Value.ValueString()); err != nil {
+			diags.AddAttributeError(
+				path.Root("properties"),
+				"Invalid Property Value",
+				fmt.Sprintf("Invalid value of %s property %s: %s.", model.Type.ValueString(), propertyKey(model.Group.ValueString(), model.Name.ValueString()), err),
+			)
+		}
+	}
+
+	return diags
+}
+
+// syncProperties makes the properties of the project match planned, and returns the new state of the attribute.
+// If planned is null, the properties are not managed and left as they are.
+func (r *ProjectResource) syncProperties(ctx context.Context, projectID uuid.UUID, planned types.Set) (types.Set, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if planned.IsNull() || planned.IsUnknown() {
+		return types.SetNull(types.ObjectType{AttrTypes: projectPropertyAttrTypes}), diags
+	}
+
+	var plannedProperties []ProjectPropertyModel
+	diags.Append(planned.ElementsAs(ctx, &plannedProperties, false)...)
+	if diags.HasError() {
+		return planned, diags
+	}
+
+	currentProperties, err := r.getProperties(ctx, projectID)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to get project properties, got error: %s", err))
+		return planned, diags
+	}
+
+	current := make(map[string]dtrack.ProjectProperty, len(currentProperties))
+	for _, property := range currentProperties {
+		current[propertyKey(property.Group, property.Name)] = property
+	}
+
+	for _, plannedProperty := range plannedProperties {
+		property := dtrack.ProjectProperty{
+			Group:       plannedProperty.Group.ValueString(),
+			Name:        plannedProperty.Name.ValueString(),
+			Value:       plannedProperty.Value.ValueString(),
+			Type:        plannedProperty.Type.ValueString(),
+			Description: plannedProperty.Description.ValueString(),
+		}
+		key := propertyKey(property.Group, property.Name)
+
+		currentProperty, exists := current[key]
+		delete(current, key)
+
+		switch {
+		case !exists:
+			_, err = r.Client.ProjectProperty.Create(ctx, projectID, property)
+		case currentProperty.Type != property.Type:
+			// The type of an existing property cannot be changed
+			err = r.Client.ProjectProperty.Delete(ctx, projectID, property.Group, property.Name)
+			if err == nil {
+				_, err = r.Client.ProjectProperty.Create(ctx, projectID, property)
+			}
+		case currentProperty.Value != property.Value || currentProperty.Description != property.Description:
+			_, err = r.Client.ProjectProperty.Update(ctx, projectID, property)
+		}
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to set project property %s, got error: %s", key, err))
+			return planned, diags
+		}
+	}
+
+	for key, property := range current {
+		err = r.Client.ProjectProperty.Delete(ctx, projectID, property.Group, property.Name)
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to delete project property %s, got error: %s", key, err))
+			return planned, diags
+		}
+	}
+
+	return planned, diags
+}
+
+// readProperties returns the properties of the project if they are managed, i.e. prior is not null. The values of
+// ENCRYPTEDSTRING properties are hidden by the API, so they are taken from prior.
+func (r *ProjectResource) readProperties(ctx context.Context, projectID uuid.UUID, prior types.Set) (types.Set, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if prior.IsNull() {
+		return prior, diags
+	}
+
+	var priorProperties []ProjectPropertyModel
+	diags.Append(prior.ElementsAs(ctx, &priorProperties, false)...)
+	if diags.HasError() {
+		return prior, diags
+	}
+
+	priorValues := make(map[string]types.String, len(priorProperties))
+	for _, property := range priorProperties {
+		priorValues[propertyKey(property.Group.ValueString(), property.Name.ValueString())] = property.Value
+	}
+
+	dtProperties, err := r.getProperties(ctx, projectID)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to get project properties, got error: %s", err))
+		return prior, diags
+	}
+
+	properties := make([]ProjectPropertyModel, len(dtProperties))
+	for i, dtProperty := range dtProperties {
+		properties[i] = ProjectPropertyModel{
+			Group:       types.StringValue(dtProperty.Group),
+			Name:        types.StringValue(dtProperty.Name),
+			Value:       types.StringValue(dtProperty.Value),
+			Type:        types.StringValue(dtProperty.Type),
+			Description: utils.StringValueOrNull(dtProperty.Description),
+		}
+
+		if dtProperty.Type == "ENCRYPTEDSTRING" && dtProperty.Value == utils.HiddenPropertyValue {
+			if priorValue, ok := priorValues[propertyKey(dtProperty.Group, dtProperty.Name)]; ok {
+				properties[i].Value = priorValue
+			}
+		}
+	}
+
+	propertiesValue, propertiesDiags := types.SetValueFrom(ctx, types.ObjectType{AttrTypes: projectPropertyAttrTypes}, properties)
+	diags.Append(propertiesDiags...)
+
+	return propertiesValue, diags
+}
+
+func (r *ProjectResource) getProperties(ctx context.Context, projectID uuid.UUID) ([]dtrack.ProjectProperty, error) {
+	return dtrack.FetchAll(func(po dtrack.PageOptions) (dtrack.Page[dtrack.ProjectProperty], error) {
+		return r.Client.ProjectProperty.GetAll(ctx, projectID, po)
+	})
+}
@@ -0,0 +1,238 @@
+// Copyright (c) 2024 Futurice Oy
+// SPDX-License-Identifier: MPL-2.0
+
+package project
+
+import (
+	"context"
+	"strings"
+
+	dtrack "github.com/futurice/dependency-track-client-go"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// externalReferenceTypes are the external reference types of CycloneDX 1.5, which Dependency-Track uses.
+var externalReferenceTypes = []string{
+	"vcs", "issue-tracker", "website", "advisories", "bom", "mailing-list", "social", "chat", "documentation",
+	"support", "distribution", "distribution-intake", "license", "build-meta", "build-system", "release-notes",
+	"security-contact", "model-card", "log", "configuration", "evidence", "formulation", "attestation",
+	"threat-model", "adversary-model", "risk-assessment", "vulnerability-assertion", "exploitability-statement",
+	"pentest-report", "static-analysis-report", "dynamic-analysis-report", "runtime-analysis-report",
+	"component-analysis-report", "maturity-report", "certification-report", "codified-infrastructure",
+	"quality-metrics", "poam", "other",
+}
+
+var organizationalContactAttrTypes = map[string]attr.Type{
+	"name":  types.StringType,
+	"email": types.StringType,
+	"phone": types.StringType,
+}
+
+var organizationalEntityAttrTypes = map[string]attr.Type{
+	"name":     types.StringType,
+	"urls":     types.ListType{ElemType: types.StringType},
+	"contacts": types.ListType{ElemType: types.ObjectType{AttrTypes: organizationalContactAttrTypes}},
+}
+
+var externalReferenceAttrTypes = map[string]attr.Type{
+	"type":    types.StringType,
+	"url":     types.StringType,
+	"comment": types.StringType,
+}
+
+// OrganizationalEntityModel describes the supplier and the manufacturer of a project.
+type OrganizationalEntityModel struct {
+	Name     types.String `tfsdk:"name"`
+	URLs     types.List   `tfsdk:"urls"`
+	Contacts types.List   `tfsdk:"contacts"`
+}
+
+// OrganizationalContactModel describes a contact of an organizational entity.
+type OrganizationalContactModel struct {
+	Name  types.String `tfsdk:"name"`
+	Email types.String `tfsdk:"email"`
+	Phone types.String `tfsdk:"phone"`
+}
+
+// ExternalReferenceModel describes an external reference of a project.
+type ExternalReferenceModel struct {
+	Type    types.String `tfsdk:"type"`
+	URL     types.String `tfsdk:"url"`
+	Comment types.String `tfsdk:"comment"`
+}
+
+func organizationalEntitySchema(description string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: description,
+		Optional:            true,
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the organization",
+				Optional:            true,
+			},
+			"urls": schema.ListAttribute{
+				MarkdownDescription: "URLs of the organization",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"contacts": schema.ListNestedAttribute{
+				MarkdownDescription: "Contacts of the organization",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the contact",
+							Optional:            true,
+						},
+						"email": schema.StringAttribute{
+							MarkdownDescription: "Email address of the contact",
+							Optional:            true,
+						},
+						"phone": schema.StringAttribute{
+							MarkdownDescription: "Phone number of the contact",
+							Optional:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func externalReferencesSchema() schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		MarkdownDescription: "External references of the project, e.g. its source code repository or website",
+		Optional:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"type": schema.StringAttribute{
+					MarkdownDescription: "Type of the reference. Must be one of the following values: [" + strings.Join(externalReferenceTypes, ", ") + "]",
+					Required:            true,
+					Validators: []validator.String{
+						stringvalidator.OneOf(externalReferenceTypes...),
+					},
+				},
+				"url": schema.StringAttribute{
+					MarkdownDescription: "URL of the reference",
+					Required:            true,
+				},
+				"comment": schema.StringAttribute{
+					MarkdownDescription: "Comment describing the reference",
+					Optional:            true,
+				},
+			},
+		},
+	}
+}
+
+func DTOrganizationalEntityToTF(ctx context.Context, entity *dtrack.OrganizationalEntity) (types.Object, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if entity == nil {
+		return types.ObjectNull(organizationalEntityAttrTypes), diags
+	}
+
+	model := OrganizationalEntityModel{
+		Name:     utils.StringValueOrNull(entity.Name),
+		URLs:     types.ListNull(types.StringType),
+		Contacts: types.ListNull(types.ObjectType{AttrTypes: organizationalContactAttrTypes}),
+	}
+
+	if len(entity.URLs) > 0 {
+		urls, urlsDiags := types.ListValueFrom(ctx, types.StringType, entity.URLs)
+		diags.Append(urlsDiags...)
+		model.URLs = urls
+	}
+
+	if len(entity.Contact) > 0 {
+		contacts := make([]OrganizationalContactModel, len(entity.Contact))
+		for i, contact := range entity.Contact {
+			contacts[i] = OrganizationalContactModel{
+				Name:  utils.StringValueOrNull(contact.Name),
+				Email: utils.StringValueOrNull(contact.Email),
+				Phone: utils.StringValueOrNull(contact.Phone),
+			}
+		}
+
+		contactsValue, contactsDiags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: organizationalContactAttrTypes}, contacts)
+		diags.Append(contactsDiags...)
+		model.Contacts = contactsValue
+	}
+
+	entityValue, entityDiags := types.ObjectValueFrom(ctx, organizationalEntityAttrTypes, model)
+	diags.Append(entityDiags...)
+
+	return entityValue, diags
+}
+
+func TFOrganizationalEntityToDT(ctx context.Context, entity types.Object) (*dtrack.OrganizationalEntity, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if entity.IsNull() || entity.IsUnknown() {
+		return nil, diags
+	}
+
+	var model OrganizationalEntityModel
+	diags.Append(entity.As(ctx, &model, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	dtEntity := &dtrack.OrganizationalEntity{
+		Name: model.Name.ValueString(),
+	}
+
+	diags.Append(model.URLs.ElementsAs(ctx, &dtEntity.URLs, false)...)
+
+	var contacts []OrganizationalContactModel
+	diags.Append(model.Contacts.ElementsAs(ctx, &contacts, false)...)
+	for _, contact := range contacts {
+		dtEntity.Contact = append(dtEntity.Contact, dtrack.OrganizationalContact{
+			Name:  contact.Name.ValueString(),
+			Email: contact.Email.ValueString(),
+			Phone: contact.Phone.ValueString(),
+		})
+	}
+
+	return dtEntity, diags
+}
+
+func DTExternalReferencesToTF(ctx context.Context, references []dtrack.ExternalReference) (types.List, diag.Diagnostics) {
+	if len(references) == 0 {
+		return types.ListNull(types.ObjectType{AttrTypes: externalReferenceAttrTypes}), nil
+	}
+
+	models := make([]ExternalReferenceModel, len(references))
+	for i, reference := range references {
+		models[i] = ExternalReferenceModel{
+			Type:    types.StringValue(reference.Type),
+			URL:     types.StringValue(reference.URL),
+			Comment: utils.StringValueOrNull(reference.Comment),
+		}
+	}
+
+	return types.ListValueFrom(ctx, types.ObjectType{AttrTypes: externalReferenceAttrTypes}, models)
+}
+
+func TFExternalReferencesToDT(ctx context.Context, references types.List) ([]dtrack.ExternalReference, diag.Diagnostics) {
+	var models []ExternalReferenceModel
+	diags := references.ElementsAs(ctx, &models, false)
+
+	var dtReferences []dtrack.ExternalReference
+	for _, model := range models {
+		dtReferences = append(dtReferences, dtrack.ExternalReference{
+			Type:    model.Type.ValueString(),
+			URL:     model.URL.ValueString(),
+			Comment: model.Comment.ValueString(),
+		})
+	}
+
+	return dtReferences, diags
+}
@@ -8,24 +8,35 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/futurice/terraform-provider-dependencytrack/internal/utils"
 
 	dtrack "github.com/futurice/dependency-track-client-go"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/providerdata"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+// isLatestMinimumVersion is the first version of Dependency-Track supporting the isLatest flag of projects.
+const isLatestMinimumVersion = "4.12.0"
+
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &ProjectResource{}
 var _ resource.ResourceWithConfigure = &ProjectResource{}
 var _ resource.ResourceWithImportState = &ProjectResource{}
+var _ resource.ResourceWithModifyPlan = &ProjectResource{}
+var _ resource.ResourceWithValidateConfig = &ProjectResource{}
 
 func NewProjectResource() resource.Resource {
 	return &ProjectResource{}
@@ -33,28 +44,33 @@ func NewProjectResource() resource.Resource {
 
 // ProjectResource defines the resource implementation.
 type ProjectResource struct {
-	client *dtrack.Client
+	providerdata.ResourceBase
 }
 
 // ProjectResourceModel describes the resource data model.
 type ProjectResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	ParentID    types.String `tfsdk:"parent_id"`
-	Name        types.String `tfsdk:"name"`
-	Classifier  types.String `tfsdk:"classifier"`
-	Description types.String `tfsdk:"description"`
-	Active      types.Bool   `tfsdk:"active"`
-	// Author             types.String `tfsdk:"author"`
-	// Publisher          types.String `tfsdk:"publisher"`
-	// Group              types.String `tfsdk:"group"`
-	// Version            types.String `tfsdk:"version"`
-	// CPE                types.String `tfsdk:"cpe"`
-	// PURL               types.String `tfsdk:"purl"`
-	// SWIDTagID          types.String `tfsdk:"swidTagId"`
-	// DirectDependencies types.String `tfsdk:"directDependencies"`
-	// Metrics            ProjectMetrics    `tfsdk:"metrics"`
-	// Properties         []ProjectProperty `tfsdk:"properties"`
-	// Tags               []Tag             `tfsdk:"tags"`
+	ID                 types.String `tfsdk:"id"`
+	ParentID           types.String `tfsdk:"parent_id"`
+	Name               types.String `tfsdk:"name"`
+	Classifier         types.String `tfsdk:"classifier"`
+	Description        types.String `tfsdk:"description"`
+	Active             types.Bool   `tfsdk:"active"`
+	IsLatest           types.Bool   `tfsdk:"is_latest"`
+	Author             types.String `tfsdk:"author"`
+	Publisher          types.String `tfsdk:"publisher"`
+	Group              types.String `tfsdk:"group"`
+	Version            types.String `tfsdk:"version"`
+	CPE                types.String `tfsdk:"cpe"`
+	PURL               types.String `tfsdk:"purl"`
+	SWIDTagID          types.String `tfsdk:"swid_tag_id"`
+	Supplier           types.Object `tfsdk:"supplier"`
+	Manufacturer       types.Object `tfsdk:"manufacturer"`
+	ExternalReferences types.List   `tfsdk:"external_references"`
+	Properties         types.Set    `tfsdk:"properties"`
+	Tags               types.Set    `tfsdk:"tags"`
+	CollectionLogic    types.String `tfsdk:"collection_logic"`
+	CollectionTag      types.String `tfsdk:"collection_tag"`
+	// DirectDependencies and Metrics are not managed by Terraform, but by BOM uploads and analysis
 }
 
 func (r *ProjectResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -80,6 +96,13 @@ func (r *ProjectResource) Schema(ctx context.Context, req resource.SchemaRequest
 				Computed:            true,
 				Default:             booldefault.StaticBool(true),
 			},
+			"is_latest": schema.BoolAttribute{
+				MarkdownDescription: "Whether the project is the latest version of the project with the same name. " +
+					"Requires Dependency-Track 4.12.0 or newer. Default is false.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
 			"parent_id": schema.StringAttribute{
 				MarkdownDescription: "Parent project UUID",
 				Optional:            true,
@@ -88,6 +111,101 @@ func (r *ProjectResource) Schema(ctx context.Context, req resource.SchemaRequest
 				MarkdownDescription: "Description of the project",
 				Optional:            true,
 			},
+			"author": schema.StringAttribute{
+				MarkdownDescription: "Author of the project",
+				Optional:            true,
+			},
+			"publisher": schema.StringAttribute{
+				MarkdownDescription: "Publisher of the project",
+				Optional:            true,
+			},
+			"group": schema.StringAttribute{
+				MarkdownDescription: "Group, namespace or vendor of the project",
+				Optional:            true,
+			},
+			"version": schema.StringAttribute{
+				MarkdownDescription: "Version of the project. The combination of name and version must be unique.",
+				Optional:            true,
+			},
+			"cpe": schema.StringAttribute{
+				MarkdownDescription: "Common Platform Enumeration (CPE) of the project, as a CPE 2.3 formatted string or a CPE 2.2 URI",
+				Optional:            true,
+				Validators: []validator.String{
+					validators.CPE(),
+				},
+			},
+			"purl": schema.StringAttribute{
+				MarkdownDescription: "Package URL (PURL) of the project, e.g. `pkg:maven/org.example/app@1.0.0`",
+				Optional:            true,
+				Validators: []validator.String{
+					validators.PURL(),
+				},
+			},
+			"swid_tag_id": schema.StringAttribute{
+				MarkdownDescription: "SWID tag ID of the project",
+				Optional:            true,
+			},
+			"supplier":            organizationalEntitySchema("Supplier of the project"),
+			"manufacturer":        organizationalEntitySchema("Manufacturer of the project"),
+			"external_references": externalReferencesSchema(),
+			"properties": schema.SetNestedAttribute{
+				MarkdownDescription: "Properties of the project. If set, the properties of the project are managed authoritatively, " +
+					"i.e. properties not listed here are removed. If not set, the properties are not managed. " +
+					"Do not manage the properties of the same project elsewhere.",
+				Optional: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"group": schema.StringAttribute{
+							MarkdownDescription: "Group name of the property",
+							Required:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the property",
+							Required:            true,
+						},
+						"value": schema.StringAttribute{
+							MarkdownDescription: "Value of the property",
+							Required:            true,
+							Sensitive:           true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "Type of the property. Must be one of the following values: [" + strings.Join(utils.PropertyTypes, ", ") + "]",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(utils.PropertyTypes...),
+							},
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "Description of the property",
+							Optional:            true,
+						},
+					},
+				},
+			},
+			"tags": schema.SetAttribute{
+				MarkdownDescription: "Tags of the project. The `default_tags` of the provider are added to these, but not shown here. " +
+					"If not set, the tags are not managed, e.g. to manage them with `dependencytrack_project_tags` instead, " +
+					"and the current tags of the project are shown.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+			},
+			"collection_logic": schema.StringAttribute{
+				MarkdownDescription: "Specifies which children the metrics of the project are aggregated from, making it a collection project. " +
+					"Must be one of the following values: [" + strings.Join(collectionLogics, ", ") + "]. " +
+					"Requires Dependency-Track 4.13.0 or newer for values other than NONE, and cannot be set on a project with components " +
+					"or with the classifier " + strings.Join(nonCollectionClassifiers, " or ") + ". Default is NONE.",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(collectionLogicNone),
+				Validators: []validator.String{
+					stringvalidator.OneOf(collectionLogics...),
+				},
+			},
+			"collection_tag": schema.StringAttribute{
+				MarkdownDescription: "Tag of the children whose metrics are aggregated. Required if and only if `collection_logic` is " + collectionLogicDirectChildrenWithTag + ".",
+				Optional:            true,
+			},
 			"id": schema.StringAttribute{
 				MarkdownDescription: "Project UUID",
 				Computed:            true,
@@ -99,23 +217,30 @@ func (r *ProjectResource) Schema(ctx context.Context, req resource.SchemaRequest
 	}
 }
 
-func (r *ProjectResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if req.ProviderData == nil {
+func (r *ProjectResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.ProviderData == nil {
 		return
 	}
 
-	client, ok := req.ProviderData.(*dtrack.Client)
+	var isLatest types.Bool
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("is_latest"), &isLatest)...)
+	if isLatest.ValueBool() {
+		resp.Diagnostics.Append(r.RequireServerVersion(path.Root("is_latest"), "The is_latest attribute", isLatestMinimumVersion)...)
+	}
+
+	r.modifyCollectionPlan(ctx, req, resp)
+}
 
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *dtrack.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
+func (r *ProjectResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config ProjectResourceModel
 
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	r.client = client
+	resp.Diagnostics.Append(validateCollection(config)...)
+	resp.Diagnostics.Append(validateProperties(ctx, config.Properties)...)
 }
 
 func (r *ProjectResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -131,8 +256,15 @@ func (r *ProjectResource) Create(ctx context.Context, req resource.CreateRequest
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	dtProject.Tags = r.WithDefaultTags(dtProject.Tags)
+	if !r.ServerVersionAtLeast(isLatestMinimumVersion) {
+		dtProject.IsLatest = nil
+	}
+	if !r.ServerVersionAtLeast(collectionMinimumVersion) {
+		dtProject.CollectionLogic = nil
+	}
 
-	respProject, err := r.client.Project.Create(ctx, dtProject)
+	respProject, err := r.Client.Project.Create(ctx, dtProject)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create project, got error: %s", err))
 		return
@@ -140,11 +272,13 @@ func (r *ProjectResource) Create(ctx context.Context, req resource.CreateRequest
 
 	state, diags = DTProjectToTFProject(ctx, respProject)
 	resp.Diagnostics.Append(diags...)
+	state.Tags, diags = r.userTags(ctx, respProject.Tags, plan.Tags)
+	resp.Diagnostics.Append(diags...)
 
 	// Workaround for https://github.com/DependencyTrack/dependency-track/issues/3883
 	//   If we do not get the project right after creating it, we might get an incorrect
 	//   response later. For one thing, this would break our tests.
-	_, err = r.client.Project.Get(ctx, respProject.UUID)
+	_, err = r.Client.Project.Get(ctx, respProject.UUID)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get newly created project, got error: %s", err))
 		return
@@ -153,6 +287,9 @@ func (r *ProjectResource) Create(ctx context.Context, req resource.CreateRequest
 	// API does not return parent ID when creating, so we assume it was set as requested
 	state.ParentID = plan.ParentID
 
+	state.Properties, diags = r.syncProperties(ctx, respProject.UUID, plan.Properties)
+	resp.Diagnostics.Append(diags...)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
@@ -171,7 +308,7 @@ func (r *ProjectResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
-	respProject, err := r.client.Project.Get(ctx, projectID)
+	respProject, err := r.Client.Project.Get(ctx, projectID)
 	if err != nil {
 		var apiErr *dtrack.APIError
 		if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
@@ -183,8 +320,13 @@ func (r *ProjectResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
+	priorState := state
 	state, diags = DTProjectToTFProject(ctx, respProject)
 	resp.Diagnostics.Append(diags...)
+	state.Tags, diags = r.userTags(ctx, respProject.Tags, priorState.Tags)
+	resp.Diagnostics.Append(diags...)
+	state.Properties, diags = r.readProperties(ctx, respProject.UUID, priorState.Properties)
+	resp.Diagnostics.Append(diags...)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
@@ -198,13 +340,33 @@ func (r *ProjectResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
+	var configTags types.Set
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("tags"), &configTags)...)
+
 	dtProject, diags := TFProjectToDTProject(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	if configTags.IsNull() {
+		// Tags are not managed here, e.g. because they are managed by dependencytrack_project_tags, so the
+		// current tags are kept
+		currentProject, err := r.Client.Project.Get(ctx, dtProject.UUID)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get current project tags, got error: %s", err))
+			return
+		}
+		dtProject.Tags = currentProject.Tags
+	}
+	dtProject.Tags = r.WithDefaultTags(dtProject.Tags)
+	if !r.ServerVersionAtLeast(isLatestMinimumVersion) {
+		dtProject.IsLatest = nil
+	}
+	if !r.ServerVersionAtLeast(collectionMinimumVersion) {
+		dtProject.CollectionLogic = nil
+	}
 
-	respProject, err := r.client.Project.Update(ctx, dtProject)
+	respProject, err := r.Client.Project.Update(ctx, dtProject)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update project, got error: %s", err))
 		return
@@ -212,10 +374,15 @@ func (r *ProjectResource) Update(ctx context.Context, req resource.UpdateRequest
 
 	state, diags = DTProjectToTFProject(ctx, respProject)
 	resp.Diagnostics.Append(diags...)
+	state.Tags, diags = r.userTags(ctx, respProject.Tags, plan.Tags)
+	resp.Diagnostics.Append(diags...)
 
 	// API does not return parent ID when updating, so we assume it was updated
 	state.ParentID = plan.ParentID
 
+	state.Properties, diags = r.syncProperties(ctx, respProject.UUID, plan.Properties)
+	resp.Diagnostics.Append(diags...)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
@@ -233,7 +400,7 @@ func (r *ProjectResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
-	err := r.client.Project.Delete(ctx, projectID)
+	err := r.Client.Project.Delete(ctx, projectID)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete project, got error: %s", err))
 		return
@@ -261,11 +428,31 @@ func DTProjectToTFProject(ctx context.Context, dtProject dtrack.Project) (Projec
 		project.ParentID = types.StringNull()
 	}
 
-	if dtProject.Description != "" {
-		project.Description = types.StringValue(dtProject.Description)
-	} else {
-		project.Description = types.StringNull()
-	}
+	project.Description = utils.StringValueOrNull(dtProject.Description)
+	project.Author = utils.StringValueOrNull(dtProject.Author)
+	project.Publisher = utils.StringValueOrNull(dtProject.Publisher)
+	project.Group = utils.StringValueOrNull(dtProject.Group)
+	project.Version = utils.StringValueOrNull(dtProject.Version)
+	project.CPE = utils.StringValueOrNull(dtProject.CPE)
+	project.PURL = utils.StringValueOrNull(dtProject.PURL)
+	project.SWIDTagID = utils.StringValueOrNull(dtProject.SWIDTagID)
+	project.IsLatest = types.BoolValue(dtProject.IsLatest != nil && *dtProject.IsLatest)
+
+	var entityDiags diag.Diagnostics
+	project.Supplier, entityDiags = DTOrganizationalEntityToTF(ctx, dtProject.Supplier)
+	diags.Append(entityDiags...)
+	project.Manufacturer, entityDiags = DTOrganizationalEntityToTF(ctx, dtProject.Manufacturer)
+	diags.Append(entityDiags...)
+
+	externalReferences, externalReferencesDiags := DTExternalReferencesToTF(ctx, dtProject.ExternalReferences)
+	project.ExternalReferences = externalReferences
+	diags.Append(externalReferencesDiags...)
+
+	project.CollectionLogic, project.CollectionTag = DTCollectionToTF(dtProject)
+
+	// Tags and properties depend on the configuration, so they are set by the resource
+	project.Tags = types.SetNull(types.StringType)
+	project.Properties = types.SetNull(types.ObjectType{AttrTypes: projectPropertyAttrTypes})
 
 	return project, diags
 }
@@ -278,6 +465,34 @@ func TFProjectToDTProject(ctx context.Context, tfProject ProjectResourceModel) (
 		Classifier:  tfProject.Classifier.ValueString(),
 		Active:      tfProject.Active.ValueBool(),
 		Description: tfProject.Description.ValueString(),
+		Author:      tfProject.Author.ValueString(),
+		Publisher:   tfProject.Publisher.ValueString(),
+		Group:       tfProject.Group.ValueString(),
+		Version:     tfProject.Version.ValueString(),
+		CPE:         tfProject.CPE.ValueString(),
+		PURL:        tfProject.PURL.ValueString(),
+		SWIDTagID:   tfProject.SWIDTagID.ValueString(),
+		IsLatest:    tfProject.IsLatest.ValueBoolPointer(),
+	}
+
+	var entityDiags diag.Diagnostics
+	project.Supplier, entityDiags = TFOrganizationalEntityToDT(ctx, tfProject.Supplier)
+	diags.Append(entityDiags...)
+	project.Manufacturer, entityDiags = TFOrganizationalEntityToDT(ctx, tfProject.Manufacturer)
+	diags.Append(entityDiags...)
+
+	externalReferences, externalReferencesDiags := TFExternalReferencesToDT(ctx, tfProject.ExternalReferences)
+	project.ExternalReferences = externalReferences
+	diags.Append(externalReferencesDiags...)
+
+	TFCollectionToDT(tfProject, &project)
+
+	if !tfProject.Tags.IsNull() && !tfProject.Tags.IsUnknown() {
+		var tags []string
+		diags.Append(tfProject.Tags.ElementsAs(ctx, &tags, false)...)
+		for _, tag := range tags {
+			project.Tags = append(project.Tags, dtrack.Tag{Name: tag})
+		}
 	}
 
 	if tfProject.ID.ValueString() != "" {
@@ -294,3 +509,18 @@ func TFProjectToDTProject(ctx context.Context, tfProject ProjectResourceModel) (
 
 	return project, diags
 }
+
+// userTags returns the tags of the project without the default tags of the provider, unless they are also in
+// configuredTags. configuredTags is null or unknown if the tags are not managed.
+func (r *ProjectResource) userTags(ctx context.Context, dtTags []dtrack.Tag, configuredTags types.Set) (types.Set, diag.Diagnostics) {
+	var configured []string
+	var diags diag.Diagnostics
+	if !configuredTags.IsNull() && !configuredTags.IsUnknown() {
+		diags.Append(configuredTags.ElementsAs(ctx, &configured, false)...)
+	}
+
+	tagsValue, tagsDiags := types.SetValueFrom(ctx, types.StringType, r.WithoutDefaultTags(dtTags, configured))
+	diags.Append(tagsDiags...)
+
+	return tagsValue, diags
+}
@@ -6,6 +6,7 @@ package project_test
 import (
 	"fmt"
 	"os"
+	"regexp"
 	"strconv"
 	"testing"
 
@@ -36,11 +37,11 @@ func TestAccProjectResource_basic(t *testing.T) {
 	otherProjectName := acctest.RandomWithPrefix("other-test-project")
 	projectResourceName := projecttestutils.CreateProjectResourceName("test")
 
-	testProject := dtrack.Project{
+	testProject := projecttestutils.WithServerDefaults(dtrack.Project{
 		Name:       projectName,
 		Classifier: "APPLICATION",
 		Active:     true,
-	}
+	})
 
 	testUpdatedProject := testProject
 	testUpdatedProject.Name = otherProjectName
@@ -84,12 +85,12 @@ func TestAccProjectResource_description(t *testing.T) {
 	projectName := acctest.RandomWithPrefix("test-project")
 	projectResourceName := projecttestutils.CreateProjectResourceName("test")
 
-	testProject := dtrack.Project{
+	testProject := projecttestutils.WithServerDefaults(dtrack.Project{
 		Name:        projectName,
 		Classifier:  "APPLICATION",
 		Description: "Description",
 		Active:      true,
-	}
+	})
 
 	testUpdatedProject := testProject
 	testUpdatedProject.Description = "Other description"
@@ -122,11 +123,11 @@ func TestAccProjectResource_inactive(t *testing.T) {
 	projectName := acctest.RandomWithPrefix("test-project")
 	projectResourceName := projecttestutils.CreateProjectResourceName("test")
 
-	testProject := dtrack.Project{
+	testProject := projecttestutils.WithServerDefaults(dtrack.Project{
 		Name:       projectName,
 		Classifier: "APPLICATION",
 		Active:     false,
-	}
+	})
 
 	testUpdatedProject := testProject
 	testUpdatedProject.Active = true
@@ -159,11 +160,11 @@ func TestAccProjectResource_classifier(t *testing.T) {
 	projectName := acctest.RandomWithPrefix("test-project")
 	projectResourceName := projecttestutils.CreateProjectResourceName("test")
 
-	testProject := dtrack.Project{
+	testProject := projecttestutils.WithServerDefaults(dtrack.Project{
 		Name:       projectName,
 		Classifier: "CONTAINER",
 		Active:     true,
-	}
+	})
 
 	testUpdatedProject := testProject
 	testUpdatedProject.Classifier = "DEVICE"
@@ -200,12 +201,12 @@ func TestAccProjectResource_parent(t *testing.T) {
 	projectName := acctest.RandomWithPrefix("test-project")
 
 	createTestProject := func(parentID *string) dtrack.Project {
-		return dtrack.Project{
+		return projecttestutils.WithServerDefaults(dtrack.Project{
 			Name:       projectName,
 			Classifier: "APPLICATION",
 			Active:     true,
 			ParentRef:  &dtrack.ParentRef{UUID: uuid.MustParse(*parentID)},
-		}
+		})
 	}
 
 	var parentProjectID, otherParentProjectID string
@@ -234,6 +235,455 @@ func TestAccProjectResource_parent(t *testing.T) {
 	})
 }
 
+func TestAccProjectResource_metadata(t *testing.T) {
+	ctx := testutils.CreateTestContext(t)
+
+	projectName := acctest.RandomWithPrefix("test-project")
+	projectResourceName := projecttestutils.CreateProjectResourceName("test")
+
+	testProject := projecttestutils.WithServerDefaults(dtrack.Project{
+		Name:       projectName,
+		Classifier: "APPLICATION",
+		Active:     true,
+		Author:     "Author",
+		Publisher:  "Publisher",
+		Group:      "org.example",
+		Version:    "1.0.0",
+		SWIDTagID:  "swidgen-242eb18a-503e-ca37-393b-cf156ef09691_9.1.1",
+	})
+
+	testUpdatedProject := testProject
+	testUpdatedProject.Author = "Other author"
+	testUpdatedProject.Publisher = "Other publisher"
+	testUpdatedProject.Group = "org.example.other"
+	testUpdatedProject.Version = "1.1.0"
+	testUpdatedProject.SWIDTagID = "swidgen-242eb18a-503e-ca37-393b-cf156ef09691_9.2.0"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectConfigMetadata(testDependencyTrack, testProject),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					projecttestutils.TestAccCheckProjectExistsAndHasExpectedData(ctx, testDependencyTrack, projectResourceName, testProject),
+					resource.TestCheckResourceAttr(projectResourceName, "author", testProject.Author),
+					resource.TestCheckResourceAttr(projectResourceName, "publisher", testProject.Publisher),
+					resource.TestCheckResourceAttr(projectResourceName, "group", testProject.Group),
+					resource.TestCheckResourceAttr(projectResourceName, "version", testProject.Version),
+					resource.TestCheckResourceAttr(projectResourceName, "swid_tag_id", testProject.SWIDTagID),
+				),
+			},
+			{
+				ResourceName:      projectResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccProjectConfigMetadata(testDependencyTrack, testUpdatedProject),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					projecttestutils.TestAccCheckProjectExistsAndHasExpectedData(ctx, testDependencyTrack, projectResourceName, testUpdatedProject),
+					resource.TestCheckResourceAttr(projectResourceName, "author", testUpdatedProject.Author),
+					resource.TestCheckResourceAttr(projectResourceName, "publisher", testUpdatedProject.Publisher),
+					resource.TestCheckResourceAttr(projectResourceName, "group", testUpdatedProject.Group),
+					resource.TestCheckResourceAttr(projectResourceName, "version", testUpdatedProject.Version),
+					resource.TestCheckResourceAttr(projectResourceName, "swid_tag_id", testUpdatedProject.SWIDTagID),
+				),
+			},
+			{
+				Config: testAccProjectConfigBasic(testDependencyTrack, projectName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr(projectResourceName, "author"),
+					resource.TestCheckNoResourceAttr(projectResourceName, "publisher"),
+					resource.TestCheckNoResourceAttr(projectResourceName, "group"),
+					resource.TestCheckNoResourceAttr(projectResourceName, "version"),
+					resource.TestCheckNoResourceAttr(projectResourceName, "swid_tag_id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccProjectResource_cpeAndPURL(t *testing.T) {
+	ctx := testutils.CreateTestContext(t)
+
+	projectName := acctest.RandomWithPrefix("test-project")
+	projectResourceName := projecttestutils.CreateProjectResourceName("test")
+
+	testProject := projecttestutils.WithServerDefaults(dtrack.Project{
+		Name:       projectName,
+		Classifier: "APPLICATION",
+		Active:     true,
+		CPE:        "cpe:2.3:a:example:" + projectName + ":1.0.0:*:*:*:*:*:*:*",
+		PURL:       "pkg:maven/org.example/" + projectName + "@1.0.0",
+	})
+
+	testUpdatedProject := testProject
+	testUpdatedProject.CPE = "cpe:2.3:a:example:" + projectName + ":1.1.0:*:*:*:*:*:*:*"
+	testUpdatedProject.PURL = "pkg:maven/org.example/" + projectName + "@1.1.0"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectConfigCPEAndPURL(testDependencyTrack, projectName, testProject.CPE, testProject.PURL),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					projecttestutils.TestAccCheckProjectExistsAndHasExpectedData(ctx, testDependencyTrack, projectResourceName, testProject),
+					resource.TestCheckResourceAttr(projectResourceName, "cpe", testProject.CPE),
+					resource.TestCheckResourceAttr(projectResourceName, "purl", testProject.PURL),
+				),
+			},
+			{
+				Config: testAccProjectConfigCPEAndPURL(testDependencyTrack, projectName, testUpdatedProject.CPE, testUpdatedProject.PURL),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					projecttestutils.TestAccCheckProjectExistsAndHasExpectedData(ctx, testDependencyTrack, projectResourceName, testUpdatedProject),
+					resource.TestCheckResourceAttr(projectResourceName, "cpe", testUpdatedProject.CPE),
+					resource.TestCheckResourceAttr(projectResourceName, "purl", testUpdatedProject.PURL),
+				),
+			},
+		},
+	})
+}
+
+func TestAccProjectResource_invalidCPEAndPURL(t *testing.T) {
+	projectName := acctest.RandomWithPrefix("test-project")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProjectConfigCPEAndPURL(testDependencyTrack, projectName, "cpe:2.3:a:example", "pkg:maven/org.example/app@1.0.0"),
+				ExpectError: regexp.MustCompile("Invalid CPE"),
+			},
+			{
+				Config:      testAccProjectConfigCPEAndPURL(testDependencyTrack, projectName, "cpe:2.3:a:example:app:1.0.0:*:*:*:*:*:*:*", "maven/org.example/app@1.0.0"),
+				ExpectError: regexp.MustCompile("Invalid Package URL"),
+			},
+		},
+	})
+}
+
+func TestAccProjectResource_tags(t *testing.T) {
+	ctx := testutils.CreateTestContext(t)
+
+	projectName := acctest.RandomWithPrefix("test-project")
+	projectResourceName := projecttestutils.CreateProjectResourceName("test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectConfigTags(testDependencyTrack, projectName, []string{"tag1", "tag2"}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(projectResourceName, "tags.#", "2"),
+					resource.TestCheckTypeSetElemAttr(projectResourceName, "tags.*", "tag1"),
+					resource.TestCheckTypeSetElemAttr(projectResourceName, "tags.*", "tag2"),
+				),
+			},
+			{
+				ResourceName:      projectResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccProjectConfigTags(testDependencyTrack, projectName, []string{"tag2", "tag3"}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(projectResourceName, "tags.#", "2"),
+					resource.TestCheckTypeSetElemAttr(projectResourceName, "tags.*", "tag2"),
+					resource.TestCheckTypeSetElemAttr(projectResourceName, "tags.*", "tag3"),
+				),
+			},
+			{
+				// Tags are no longer managed, so they are left as they are
+				Config: testAccProjectConfigBasic(testDependencyTrack, projectName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(projectResourceName, "tags.#", "2"),
+					projecttestutils.TestAccCheckProjectHasExpectedTags(ctx, testDependencyTrack, projectResourceName, []string{"tag2", "tag3"}),
+				),
+			},
+			{
+				// Importing follows the tags of the server, so there is no diff when the config does not set them
+				ResourceName:      projectResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config:   testAccProjectConfigBasic(testDependencyTrack, projectName),
+				PlanOnly: true,
+			},
+			{
+				Config: testAccProjectConfigTags(testDependencyTrack, projectName, []string{}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(projectResourceName, "tags.#", "0"),
+					projecttestutils.TestAccCheckProjectHasExpectedTags(ctx, testDependencyTrack, projectResourceName, []string{}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccProjectResource_properties(t *testing.T) {
+	projectName := acctest.RandomWithPrefix("test-project")
+	projectResourceName := projecttestutils.CreateProjectResourceName("test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectConfigProperties(testDependencyTrack, projectName, "value", "secret"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(projectResourceName, "properties.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(projectResourceName, "properties.*", map[string]string{
+						"group":       "build",
+						"name":        "repository",
+						"value":       "value",
+						"type":        "STRING",
+						"description": "Source repository",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(projectResourceName, "properties.*", map[string]string{
+						"group": "build",
+						"name":  "token",
+						"value": "secret",
+						"type":  "ENCRYPTEDSTRING",
+					}),
+				),
+			},
+			{
+				Config: testAccProjectConfigProperties(testDependencyTrack, projectName, "other value", "other secret"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(projectResourceName, "properties.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(projectResourceName, "properties.*", map[string]string{
+						"name":  "repository",
+						"value": "other value",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(projectResourceName, "properties.*", map[string]string{
+						"name":  "token",
+						"value": "other secret",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccProjectResource_isLatest(t *testing.T) {
+	projectName := acctest.RandomWithPrefix("test-project")
+	projectResourceName := projecttestutils.CreateProjectResourceName("test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectConfigIsLatest(testDependencyTrack, projectName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(projectResourceName, "is_latest", "true"),
+				),
+			},
+			{
+				ResourceName:      projectResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccProjectConfigIsLatest(testDependencyTrack, projectName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(projectResourceName, "is_latest", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccProjectResource_supplierAndManufacturer(t *testing.T) {
+	projectName := acctest.RandomWithPrefix("test-project")
+	projectResourceName := projecttestutils.CreateProjectResourceName("test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectConfigSupplierAndManufacturer(testDependencyTrack, projectName, "Supplier"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(projectResourceName, "supplier.name", "Supplier"),
+					resource.TestCheckResourceAttr(projectResourceName, "supplier.urls.#", "1"),
+					resource.TestCheckResourceAttr(projectResourceName, "supplier.urls.0", "https://supplier.example.com"),
+					resource.TestCheckResourceAttr(projectResourceName, "supplier.contacts.#", "1"),
+					resource.TestCheckResourceAttr(projectResourceName, "supplier.contacts.0.name", "Contact"),
+					resource.TestCheckResourceAttr(projectResourceName, "supplier.contacts.0.email", "contact@example.com"),
+					resource.TestCheckResourceAttr(projectResourceName, "manufacturer.name", "Manufacturer"),
+					resource.TestCheckNoResourceAttr(projectResourceName, "manufacturer.urls"),
+				),
+			},
+			{
+				ResourceName:      projectResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccProjectConfigSupplierAndManufacturer(testDependencyTrack, projectName, "Other supplier"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(projectResourceName, "supplier.name", "Other supplier"),
+				),
+			},
+			{
+				Config: testAccProjectConfigBasic(testDependencyTrack, projectName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr(projectResourceName, "supplier"),
+					resource.TestCheckNoResourceAttr(projectResourceName, "manufacturer"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccProjectResource_externalReferences(t *testing.T) {
+	projectName := acctest.RandomWithPrefix("test-project")
+	projectResourceName := projecttestutils.CreateProjectResourceName("test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectConfigExternalReferences(testDependencyTrack, projectName, "vcs"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(projectResourceName, "external_references.#", "2"),
+					resource.TestCheckResourceAttr(projectResourceName, "external_references.0.type", "vcs"),
+					resource.TestCheckResourceAttr(projectResourceName, "external_references.0.url", "https://github.com/example/app"),
+					resource.TestCheckResourceAttr(projectResourceName, "external_references.0.comment", "Source code"),
+					resource.TestCheckResourceAttr(projectResourceName, "external_references.1.type", "website"),
+					resource.TestCheckNoResourceAttr(projectResourceName, "external_references.1.comment"),
+				),
+			},
+			{
+				ResourceName:      projectResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config:      testAccProjectConfigExternalReferences(testDependencyTrack, projectName, "source-code"),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Value Match`),
+			},
+			{
+				Config: testAccProjectConfigBasic(testDependencyTrack, projectName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr(projectResourceName, "external_references"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccProjectResource_collection(t *testing.T) {
+	projectName := acctest.RandomWithPrefix("test-project")
+	projectResourceName := projecttestutils.CreateProjectResourceName("test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectConfigCollection(testDependencyTrack, projectName, "AGGREGATE_DIRECT_CHILDREN", ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(projectResourceName, "collection_logic", "AGGREGATE_DIRECT_CHILDREN"),
+					resource.TestCheckNoResourceAttr(projectResourceName, "collection_tag"),
+				),
+			},
+			{
+				ResourceName:      projectResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccProjectConfigCollection(testDependencyTrack, projectName, "AGGREGATE_DIRECT_CHILDREN_WITH_TAG", "product-line"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(projectResourceName, "collection_logic", "AGGREGATE_DIRECT_CHILDREN_WITH_TAG"),
+					resource.TestCheckResourceAttr(projectResourceName, "collection_tag", "product-line"),
+				),
+			},
+			{
+				Config: testAccProjectConfigBasic(testDependencyTrack, projectName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(projectResourceName, "collection_logic", "NONE"),
+					resource.TestCheckNoResourceAttr(projectResourceName, "collection_tag"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccProjectResource_collectionWithComponents(t *testing.T) {
+	ctx := testutils.CreateTestContext(t)
+
+	projectName := acctest.RandomWithPrefix("test-project")
+	projectResourceName := projecttestutils.CreateProjectResourceName("test")
+
+	var projectID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectConfigBasic(testDependencyTrack, projectName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testutils.TestAccCheckGetResourceID(projectResourceName, &projectID),
+				),
+			},
+			{
+				PreConfig: func() {
+					_, err := testDependencyTrack.Client.Component.Create(ctx, uuid.MustParse(projectID), dtrack.Component{
+						Name:    "example-lib",
+						Version: "1.0.0",
+					})
+					if err != nil {
+						t.Fatalf("Failed to create component: %v", err)
+					}
+				},
+				Config:      testAccProjectConfigCollection(testDependencyTrack, projectName, "AGGREGATE_DIRECT_CHILDREN", ""),
+				ExpectError: regexp.MustCompile(`Collection Project With Components`),
+			},
+		},
+	})
+}
+
+func TestAccProjectResource_invalidCollection(t *testing.T) {
+	projectName := acctest.RandomWithPrefix("test-project")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProjectConfigCollection(testDependencyTrack, projectName, "AGGREGATE_DIRECT_CHILDREN_WITH_TAG", ""),
+				ExpectError: regexp.MustCompile(`Missing Collection Tag`),
+			},
+			{
+				Config:      testAccProjectConfigCollection(testDependencyTrack, projectName, "AGGREGATE_DIRECT_CHILDREN", "product-line"),
+				ExpectError: regexp.MustCompile(`Invalid Collection Tag`),
+			},
+			{
+				Config:      testAccProjectConfigCollection(testDependencyTrack, projectName, "AGGREGATE_ALL", ""),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Value Match`),
+			},
+			{
+				Config:      testAccProjectConfigCollectionClassifier(testDependencyTrack, projectName, "FILE"),
+				ExpectError: regexp.MustCompile(`Invalid Collection Classifier`),
+			},
+			{
+				Config:      testAccProjectConfigCollectionClassifier(testDependencyTrack, projectName, "DATA"),
+				ExpectError: regexp.MustCompile(`Invalid Collection Classifier`),
+			},
+		},
+	})
+}
+
 func testAccProjectConfigBasic(testDependencyTrack *testutils.TestDependencyTrack, projectName string) string {
 	return testDependencyTrack.AddProviderConfiguration(
 		fmt.Sprintf(`
@@ -349,3 +799,177 @@ resource "dependencytrack_project" "test" {
 		),
 	)
 }
+
+func testAccProjectConfigMetadata(testDependencyTrack *testutils.TestDependencyTrack, project dtrack.Project) string {
+	return testDependencyTrack.AddProviderConfiguration(
+		fmt.Sprintf(`
+resource "dependencytrack_project" "test" {
+	name        = %[1]q
+	classifier  = "APPLICATION"
+	author      = %[2]q
+	publisher   = %[3]q
+	group       = %[4]q
+	version     = %[5]q
+	swid_tag_id = %[6]q
+}
+`,
+			project.Name, project.Author, project.Publisher, project.Group, project.Version, project.SWIDTagID,
+		),
+	)
+}
+
+func testAccProjectConfigCPEAndPURL(testDependencyTrack *testutils.TestDependencyTrack, projectName, cpe, purl string) string {
+	return testDependencyTrack.AddProviderConfiguration(
+		fmt.Sprintf(`
+resource "dependencytrack_project" "test" {
+	name        = %[1]q
+	classifier  = "APPLICATION"
+	cpe         = %[2]q
+	purl        = %[3]q
+}
+`,
+			projectName, cpe, purl,
+		),
+	)
+}
+
+func testAccProjectConfigTags(testDependencyTrack *testutils.TestDependencyTrack, projectName string, tags []string) string {
+	return testDependencyTrack.AddProviderConfiguration(
+		fmt.Sprintf(`
+resource "dependencytrack_project" "test" {
+	name        = %[1]q
+	classifier  = "APPLICATION"
+	tags        = [%[2]s]
+}
+`,
+			projectName, testutils.QuoteList(tags),
+		),
+	)
+}
+
+func testAccProjectConfigProperties(testDependencyTrack *testutils.TestDependencyTrack, projectName, value, secret string) string {
+	return testDependencyTrack.AddProviderConfiguration(
+		fmt.Sprintf(`
+resource "dependencytrack_project" "test" {
+	name        = %[1]q
+	classifier  = "APPLICATION"
+	properties  = [
+		{
+			group       = "build"
+			name        = "repository"
+			value       = %[2]q
+			type        = "STRING"
+			description = "Source repository"
+		},
+		{
+			group       = "build"
+			name        = "token"
+			value       = %[3]q
+			type        = "ENCRYPTEDSTRING"
+		},
+	]
+}
+`,
+			projectName, value, secret,
+		),
+	)
+}
+
+func testAccProjectConfigIsLatest(testDependencyTrack *testutils.TestDependencyTrack, projectName string, isLatest bool) string {
+	return testDependencyTrack.AddProviderConfiguration(
+		fmt.Sprintf(`
+resource "dependencytrack_project" "test" {
+	name        = %[1]q
+	classifier  = "APPLICATION"
+	version     = "1.0.0"
+	is_latest   = %[2]t
+}
+`,
+			projectName, isLatest,
+		),
+	)
+}
+
+func testAccProjectConfigSupplierAndManufacturer(testDependencyTrack *testutils.TestDependencyTrack, projectName, supplierName string) string {
+	return testDependencyTrack.AddProviderConfiguration(
+		fmt.Sprintf(`
+resource "dependencytrack_project" "test" {
+	name        = %[1]q
+	classifier  = "APPLICATION"
+	supplier    = {
+		name     = %[2]q
+		urls     = ["https://supplier.example.com"]
+		contacts = [
+			{
+				name  = "Contact"
+				email = "contact@example.com"
+			},
+		]
+	}
+	manufacturer = {
+		name = "Manufacturer"
+	}
+}
+`,
+			projectName, supplierName,
+		),
+	)
+}
+
+func testAccProjectConfigExternalReferences(testDependencyTrack *testutils.TestDependencyTrack, projectName, referenceType string) string {
+	return testDependencyTrack.AddProviderConfiguration(
+		fmt.Sprintf(`
+resource "dependencytrack_project" "test" {
+	name                = %[1]q
+	classifier          = "APPLICATION"
+	external_references = [
+		{
+			type    = %[2]q
+			url     = "https://github.com/example/app"
+			comment = "Source code"
+		},
+		{
+			type = "website"
+			url  = "https://example.com"
+		},
+	]
+}
+`,
+			projectName, referenceType,
+		),
+	)
+}
+
+func testAccProjectConfigCollection(testDependencyTrack *testutils.TestDependencyTrack, projectName, collectionLogic, collectionTag string) string {
+	collectionTagAttribute := ""
+	if collectionTag != "" {
+		collectionTagAttribute = fmt.Sprintf("collection_tag   = %q", collectionTag)
+	}
+
+	return testDependencyTrack.AddProviderConfiguration(
+		fmt.Sprintf(`
+resource "dependencytrack_project" "test" {
+	name             = %[1]q
+	classifier       = "APPLICATION"
+	collection_logic = %[2]q
+	%[3]s
+}
+`,
+			projectName, collectionLogic, collectionTagAttribute,
+		),
+	)
+}
+
+func testAccProjectConfigCollectionClassifier(testDependencyTrack *testutils.TestDependencyTrack, projectName, classifier string) string {
+	return testDependencyTrack.AddProviderConfiguration(
+		fmt.Sprintf(`
+resource "dependencytrack_project" "test" {
+	name             = %[1]q
+	classifier       = %[2]q
+	collection_logic = "AGGREGATE_DIRECT_CHILDREN"
+}
+`,
+			projectName, classifier,
+		),
+	)
+}
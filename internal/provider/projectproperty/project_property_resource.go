@@ -0,0 +1,323 @@
+// Copyright (c) 2024 Futurice Oy
+// SPDX-License-Identifier: MPL-2.0
+
+package projectproperty
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	dtrack "github.com/futurice/dependency-track-client-go"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/providerdata"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/utils"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ProjectPropertyResource{}
+var _ resource.ResourceWithImportState = &ProjectPropertyResource{}
+var _ resource.ResourceWithValidateConfig = &ProjectPropertyResource{}
+
+func NewProjectPropertyResource() resource.Resource {
+	return &ProjectPropertyResource{}
+}
+
+// ProjectPropertyResource defines the resource implementation.
+type ProjectPropertyResource struct {
+	providerdata.ResourceBase
+}
+
+// ProjectPropertyResourceModel describes the resource data model.
+type ProjectPropertyResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	ProjectID   types.String `tfsdk:"project_id"`
+	Group       types.String `tfsdk:"group"`
+	Name        types.String `tfsdk:"name"`
+	Value       types.String `tfsdk:"value"`
+	Type        types.String `tfsdk:"type"`
+	Description types.String `tfsdk:"description"`
+}
+
+func (r *ProjectPropertyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_property"
+}
+
+func (r *ProjectPropertyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Project property. Do not use together with the `properties` attribute of the same project.",
+
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "Project UUID",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"group": schema.StringAttribute{
+				MarkdownDescription: "Group name of the property",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the property",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"value": schema.StringAttribute{
+				MarkdownDescription: "Value of the property. Must be valid for the type of the property, e.g. `true` or `false` for BOOLEAN.",
+				Required:            true,
+				// The value is a secret only for ENCRYPTEDSTRING properties. The value is still always sensitive, since
+				// the sensitivity of an attribute can not depend on another attribute, and the type can change.
+				Sensitive: true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Type of the property. Must be one of the following values: [" + strings.Join(utils.PropertyTypes, ", ") + "]",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(utils.PropertyTypes...),
+				},
+				PlanModifiers: []planmodifier.String{
+					// The type of an existing property cannot be changed
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the property",
+				Optional:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Synthetic property ID in the form of project_id/group/name",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ProjectPropertyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config ProjectPropertyResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Type.IsNull() || config.Type.IsUnknown() || config.Value.IsNull() || config.Value.IsUnknown() {
+		return
+	}
+
+	if err := utils.ValidatePropertyValue(config.Type.ValueString(), config.Value.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("value"),
+			"Invalid Property Value",
+			fmt.Sprintf("Invalid value of %s property: %s.", config.Type.ValueString(), err),
+		)
+	}
+}
+
+func (r *ProjectPropertyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan, state ProjectPropertyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID, projectIDDiags := utils.ParseAttributeUUID(plan.ProjectID.ValueString(), "project_id")
+	resp.Diagnostics.Append(projectIDDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	respProperty, err := r.Client.ProjectProperty.Create(ctx, projectID, TFProjectPropertyToDTProjectProperty(plan))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create project property, got error: %s", err))
+		return
+	}
+
+	state = DTProjectPropertyToTFProjectProperty(projectID, respProperty, plan.Value)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ProjectPropertyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ProjectPropertyResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID, projectIDDiags := utils.ParseAttributeUUID(state.ProjectID.ValueString(), "project_id")
+	resp.Diagnostics.Append(projectIDDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	property, propertyDiags := r.findProjectProperty(ctx, projectID, state.Group.ValueString(), state.Name.ValueString())
+	resp.Diagnostics.Append(propertyDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if property == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state = DTProjectPropertyToTFProjectProperty(projectID, *property, state.Value)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ProjectPropertyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state ProjectPropertyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// only value and description can change via Update, other changes require replacement
+	projectID, projectIDDiags := utils.ParseAttributeUUID(state.ProjectID.ValueString(), "project_id")
+	resp.Diagnostics.Append(projectIDDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	respProperty, err := r.Client.ProjectProperty.Update(ctx, projectID, TFProjectPropertyToDTProjectProperty(plan))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update project property, got error: %s", err))
+		return
+	}
+
+	state = DTProjectPropertyToTFProjectProperty(projectID, respProperty, plan.Value)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ProjectPropertyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ProjectPropertyResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID, projectIDDiags := utils.ParseAttributeUUID(state.ProjectID.ValueString(), "project_id")
+	resp.Diagnostics.Append(projectIDDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.Client.ProjectProperty.Delete(ctx, projectID, state.Group.ValueString(), state.Name.ValueString())
+	if err != nil {
+		var apiErr *dtrack.APIError
+		if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete project property, got error: %s", err))
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *ProjectPropertyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.SplitN(req.ID, "/", 3)
+	if len(parts) != 3 {
+		resp.Diagnostics.AddError("Invalid import ID", fmt.Sprintf("Expected ID in the format 'project_id/group/name', got [%s]", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("group"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), parts[2])...)
+}
+
+func (r *ProjectPropertyResource) findProjectProperty(ctx context.Context, projectID uuid.UUID, group, name string) (*dtrack.ProjectProperty, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	properties, err := dtrack.FetchAll(func(po dtrack.PageOptions) (dtrack.Page[dtrack.ProjectProperty], error) {
+		return r.Client.ProjectProperty.GetAll(ctx, projectID, po)
+	})
+	if err != nil {
+		var apiErr *dtrack.APIError
+		if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
+			return nil, diags
+		}
+
+		diags.AddError("Client Error", fmt.Sprintf("Unable to get project properties, got error: %s", err))
+		return nil, diags
+	}
+
+	for _, property := range properties {
+		if property.Group == group && property.Name == name {
+			return &property, diags
+		}
+	}
+
+	return nil, diags
+}
+
+// DTProjectPropertyToTFProjectProperty converts the property returned by the API. The API hides the values of
+// ENCRYPTEDSTRING properties, so knownValue is used for them instead.
+func DTProjectPropertyToTFProjectProperty(projectID uuid.UUID, dtProperty dtrack.ProjectProperty, knownValue types.String) ProjectPropertyResourceModel {
+	property := ProjectPropertyResourceModel{
+		ID:          types.StringValue(makeProjectPropertyID(projectID, dtProperty.Group, dtProperty.Name)),
+		ProjectID:   types.StringValue(projectID.String()),
+		Group:       types.StringValue(dtProperty.Group),
+		Name:        types.StringValue(dtProperty.Name),
+		Value:       types.StringValue(dtProperty.Value),
+		Type:        types.StringValue(dtProperty.Type),
+		Description: types.StringNull(),
+	}
+
+	if dtProperty.Description != "" {
+		property.Description = types.StringValue(dtProperty.Description)
+	}
+
+	if dtProperty.Type == "ENCRYPTEDSTRING" && (dtProperty.Value == utils.HiddenPropertyValue || dtProperty.Value == "") {
+		// After import the value is unknown, so it is left null to be set by the next apply
+		property.Value = knownValue
+	}
+
+	return property
+}
+
+func TFProjectPropertyToDTProjectProperty(tfProperty ProjectPropertyResourceModel) dtrack.ProjectProperty {
+	return dtrack.ProjectProperty{
+		Group:       tfProperty.Group.ValueString(),
+		Name:        tfProperty.Name.ValueString(),
+		Value:       tfProperty.Value.ValueString(),
+		Type:        tfProperty.Type.ValueString(),
+		Description: tfProperty.Description.ValueString(),
+	}
+}
+
+func makeProjectPropertyID(projectID uuid.UUID, group, name string) string {
+	return fmt.Sprintf("%s/%s/%s", projectID.String(), group, name)
+}
@@ -0,0 +1,177 @@
+// Copyright (c) 2024 Futurice Oy
+// SPDX-License-Identifier: MPL-2.0
+
+package projectproperty_test
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	dtrack "github.com/futurice/dependency-track-client-go"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/testutils"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/testutils/projecttestutils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+var testDependencyTrack *testutils.TestDependencyTrack
+
+func TestMain(m *testing.M) {
+	if os.Getenv(resource.EnvTfAcc) != "" {
+		var cleanup func()
+		testDependencyTrack, cleanup = testutils.InitTestDependencyTrack()
+		defer cleanup()
+	}
+
+	m.Run()
+}
+
+func TestAccProjectPropertyResource_basic(t *testing.T) {
+	ctx := testutils.CreateTestContext(t)
+
+	projectName := acctest.RandomWithPrefix("test-project")
+	projectResourceName := projecttestutils.CreateProjectResourceName("test")
+	propertyResourceName := projecttestutils.CreateProjectPropertyResourceName("test")
+
+	var projectID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectPropertyConfigBasic(testDependencyTrack, projectName, "STRING", "JIRA", "Jira project key"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testutils.TestAccCheckGetResourceID(projectResourceName, &projectID),
+					projecttestutils.TestAccCheckProjectHasExpectedProperties(ctx, testDependencyTrack, projectResourceName, []dtrack.ProjectProperty{
+						{Group: "integrations", Name: "jira.project", Value: "JIRA", Type: "STRING", Description: "Jira project key"},
+					}),
+					resource.TestCheckResourceAttrPtr(propertyResourceName, "project_id", &projectID),
+					resource.TestCheckResourceAttr(propertyResourceName, "group", "integrations"),
+					resource.TestCheckResourceAttr(propertyResourceName, "name", "jira.project"),
+					resource.TestCheckResourceAttr(propertyResourceName, "value", "JIRA"),
+					resource.TestCheckResourceAttr(propertyResourceName, "type", "STRING"),
+					resource.TestCheckResourceAttr(propertyResourceName, "description", "Jira project key"),
+				),
+			},
+			{
+				ResourceName:      propertyResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccProjectPropertyConfigBasic(testDependencyTrack, projectName, "STRING", "OTHER", "Other Jira project key"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					projecttestutils.TestAccCheckProjectHasExpectedProperties(ctx, testDependencyTrack, projectResourceName, []dtrack.ProjectProperty{
+						{Group: "integrations", Name: "jira.project", Value: "OTHER", Type: "STRING", Description: "Other Jira project key"},
+					}),
+					resource.TestCheckResourceAttr(propertyResourceName, "value", "OTHER"),
+					resource.TestCheckResourceAttr(propertyResourceName, "description", "Other Jira project key"),
+				),
+			},
+			{
+				Config: testAccProjectPropertyConfigBasic(testDependencyTrack, projectName, "INTEGER", "42", "Jira project number"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					projecttestutils.TestAccCheckProjectHasExpectedProperties(ctx, testDependencyTrack, projectResourceName, []dtrack.ProjectProperty{
+						{Group: "integrations", Name: "jira.project", Value: "42", Type: "INTEGER", Description: "Jira project number"},
+					}),
+					resource.TestCheckResourceAttr(propertyResourceName, "type", "INTEGER"),
+				),
+			},
+			{
+				Config: testAccProjectPropertyConfigNoProperty(testDependencyTrack, projectName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					projecttestutils.TestAccCheckProjectHasExpectedProperties(ctx, testDependencyTrack, projectResourceName, []dtrack.ProjectProperty{}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccProjectPropertyResource_encrypted(t *testing.T) {
+	projectName := acctest.RandomWithPrefix("test-project")
+	propertyResourceName := projecttestutils.CreateProjectPropertyResourceName("test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectPropertyConfigBasic(testDependencyTrack, projectName, "ENCRYPTEDSTRING", "secret", "Jira token"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(propertyResourceName, "value", "secret"),
+					resource.TestCheckResourceAttr(propertyResourceName, "type", "ENCRYPTEDSTRING"),
+				),
+			},
+			{
+				ResourceName:      propertyResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The API does not return the values of encrypted properties
+				ImportStateVerifyIgnore: []string{"value"},
+			},
+			{
+				Config: testAccProjectPropertyConfigBasic(testDependencyTrack, projectName, "ENCRYPTEDSTRING", "other secret", "Jira token"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(propertyResourceName, "value", "other secret"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccProjectPropertyResource_invalidValue(t *testing.T) {
+	projectName := acctest.RandomWithPrefix("test-project")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProjectPropertyConfigBasic(testDependencyTrack, projectName, "BOOLEAN", "yes", "Enabled"),
+				ExpectError: regexp.MustCompile(`Invalid Property Value`),
+			},
+			{
+				Config:      testAccProjectPropertyConfigBasic(testDependencyTrack, projectName, "INTEGER", "1.5", "Count"),
+				ExpectError: regexp.MustCompile(`Invalid Property Value`),
+			},
+			{
+				Config:      testAccProjectPropertyConfigBasic(testDependencyTrack, projectName, "TEXT", "value", "Text"),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Value Match`),
+			},
+		},
+	})
+}
+
+func testAccProjectPropertyConfigNoProperty(testDependencyTrack *testutils.TestDependencyTrack, projectName string) string {
+	return testDependencyTrack.AddProviderConfiguration(
+		fmt.Sprintf(`
+resource "dependencytrack_project" "test" {
+	name        = %[1]q
+	classifier  = "APPLICATION"
+}
+`,
+			projectName,
+		),
+	)
+}
+
+func testAccProjectPropertyConfigBasic(testDependencyTrack *testutils.TestDependencyTrack, projectName, propertyType, value, description string) string {
+	return testutils.ComposeConfigs(
+		testAccProjectPropertyConfigNoProperty(testDependencyTrack, projectName),
+		fmt.Sprintf(`
+resource "dependencytrack_project_property" "test" {
+	project_id  = dependencytrack_project.test.id
+	group       = "integrations"
+	name        = "jira.project"
+	value       = %[1]q
+	type        = %[2]q
+	description = %[3]q
+}
+`,
+			value, propertyType, description,
+		),
+	)
+}
@@ -0,0 +1,322 @@
+// Copyright (c) 2024 Futurice Oy
+// SPDX-License-Identifier: MPL-2.0
+
+package projecttags
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+
+	dtrack "github.com/futurice/dependency-track-client-go"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/providerdata"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/utils"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ProjectTagsResource{}
+var _ resource.ResourceWithImportState = &ProjectTagsResource{}
+var _ resource.ResourceWithModifyPlan = &ProjectTagsResource{}
+
+// tagEndpointsMinimumVersion is the first Dependency-Track version with endpoints for adding and removing tags of
+// projects.
+const tagEndpointsMinimumVersion = "4.12.0"
+
+func NewProjectTagsResource() resource.Resource {
+	return &ProjectTagsResource{}
+}
+
+// ProjectTagsResource defines the resource implementation.
+type ProjectTagsResource struct {
+	providerdata.ResourceBase
+}
+
+// ProjectTagsResourceModel describes the resource data model.
+type ProjectTagsResourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	ProjectID types.String `tfsdk:"project_id"`
+	Tags      types.Set    `tfsdk:"tags"`
+}
+
+func (r *ProjectTagsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_tags"
+}
+
+func (r *ProjectTagsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Tags of a project, managed authoritatively, i.e. tags not listed here are removed from the project. " +
+			"Do not set the `tags` attribute of the same project in `dependencytrack_project`. Requires Dependency-Track >= 4.12.0.",
+
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "Project UUID",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"tags": schema.SetAttribute{
+				MarkdownDescription: "Tags of the project. The `default_tags` of the provider are added to these, but not shown here.",
+				ElementType:         types.StringType,
+				Required:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Project UUID, same as project_id",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ProjectTagsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.ProviderData == nil {
+		return
+	}
+
+	resp.Diagnostics.Append(r.RequireServerVersion(path.Empty(), "The dependencytrack_project_tags resource", tagEndpointsMinimumVersion)...)
+}
+
+func (r *ProjectTagsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ProjectTagsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID, projectIDDiags := utils.ParseAttributeUUID(plan.ProjectID.ValueString(), "project_id")
+	resp.Diagnostics.Append(projectIDDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, diags := r.setTags(ctx, projectID, plan.Tags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ProjectTagsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ProjectTagsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID, projectIDDiags := utils.ParseAttributeUUID(state.ProjectID.ValueString(), "project_id")
+	resp.Diagnostics.Append(projectIDDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	project, err := r.Client.Project.Get(ctx, projectID)
+	if err != nil {
+		var apiErr *dtrack.APIError
+		if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read project tags, got error: %s", err))
+		return
+	}
+
+	state, diags := r.projectTagsToTF(ctx, project, state.Tags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ProjectTagsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ProjectTagsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// only tags can change via Update, project_id requires replacement
+	projectID, projectIDDiags := utils.ParseAttributeUUID(plan.ProjectID.ValueString(), "project_id")
+	resp.Diagnostics.Append(projectIDDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, diags := r.setTags(ctx, projectID, plan.Tags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ProjectTagsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ProjectTagsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID, projectIDDiags := utils.ParseAttributeUUID(state.ProjectID.ValueString(), "project_id")
+	resp.Diagnostics.Append(projectIDDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	project, err := r.Client.Project.Get(ctx, projectID)
+	if err != nil {
+		var apiErr *dtrack.APIError
+		if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get project, got error: %s", err))
+		return
+	}
+
+	// Only the default tags of the provider are left on the project
+	resp.Diagnostics.Append(r.replaceTags(ctx, project, nil)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *ProjectTagsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("tags"), types.SetValueMust(types.StringType, nil))...)
+}
+
+// setTags replaces the tags of the project with tags and the default tags of the provider.
+func (r *ProjectTagsResource) setTags(ctx context.Context, projectID uuid.UUID, tags types.Set) (ProjectTagsResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var names []string
+	diags.Append(tags.ElementsAs(ctx, &names, false)...)
+	if diags.HasError() {
+		return ProjectTagsResourceModel{}, diags
+	}
+
+	project, err := r.Client.Project.Get(ctx, projectID)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to get project, got error: %s", err))
+		return ProjectTagsResourceModel{}, diags
+	}
+
+	diags.Append(r.replaceTags(ctx, project, names)...)
+	if diags.HasError() {
+		return ProjectTagsResourceModel{}, diags
+	}
+
+	project, err = r.Client.Project.Get(ctx, projectID)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to get project tags, got error: %s", err))
+		return ProjectTagsResourceModel{}, diags
+	}
+
+	return r.projectTagsToTF(ctx, project, tags)
+}
+
+// replaceTags replaces the current tags of the project with names and the default tags of the provider. The tags are
+// assigned and unassigned with the tag endpoints, so that the other attributes of the project, which may be managed
+// concurrently by dependencytrack_project, are not written.
+func (r *ProjectTagsResource) replaceTags(ctx context.Context, project dtrack.Project, names []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	wanted := make(map[string]bool)
+	for _, tag := range r.WithDefaultTags(tagsFromNames(names)) {
+		wanted[tag.Name] = true
+	}
+
+	current := make(map[string]bool, len(project.Tags))
+	for _, tag := range project.Tags {
+		current[tag.Name] = true
+	}
+
+	var added []string
+	for name := range wanted {
+		if !current[name] {
+			added = append(added, name)
+		}
+	}
+	sort.Strings(added)
+
+	if len(added) > 0 {
+		// tags must exist before they can be assigned, existing ones are left as they are
+		err := r.Client.Tag.Create(ctx, added)
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to create tags, got error: %s", err))
+			return diags
+		}
+	}
+
+	for _, name := range added {
+		err := r.Client.Tag.TagProjects(ctx, name, []uuid.UUID{project.UUID})
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to add tag %s to project, got error: %s", name, err))
+			return diags
+		}
+	}
+
+	for _, tag := range project.Tags {
+		if wanted[tag.Name] {
+			continue
+		}
+
+		err := r.Client.Tag.UntagProjects(ctx, tag.Name, []uuid.UUID{project.UUID})
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to remove tag %s from project, got error: %s", tag.Name, err))
+			return diags
+		}
+	}
+
+	return diags
+}
+
+func tagsFromNames(names []string) []dtrack.Tag {
+	tags := make([]dtrack.Tag, len(names))
+	for i, name := range names {
+		tags[i] = dtrack.Tag{Name: name}
+	}
+
+	return tags
+}
+
+func (r *ProjectTagsResource) projectTagsToTF(ctx context.Context, project dtrack.Project, configuredTags types.Set) (ProjectTagsResourceModel, diag.Diagnostics) {
+	var configured []string
+	diags := configuredTags.ElementsAs(ctx, &configured, false)
+
+	tags, tagsDiags := types.SetValueFrom(ctx, types.StringType, r.WithoutDefaultTags(project.Tags, configured))
+	diags.Append(tagsDiags...)
+
+	return ProjectTagsResourceModel{
+		ID:        types.StringValue(project.UUID.String()),
+		ProjectID: types.StringValue(project.UUID.String()),
+		Tags:      tags,
+	}, diags
+}
@@ -0,0 +1,155 @@
+// Copyright (c) 2024 Futurice Oy
+// SPDX-License-Identifier: MPL-2.0
+
+package projecttags_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	dtrack "github.com/futurice/dependency-track-client-go"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/testutils"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/testutils/projecttestutils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+var testDependencyTrack *testutils.TestDependencyTrack
+
+func TestMain(m *testing.M) {
+	if os.Getenv(resource.EnvTfAcc) != "" {
+		var cleanup func()
+		testDependencyTrack, cleanup = testutils.InitTestDependencyTrack()
+		defer cleanup()
+	}
+
+	m.Run()
+}
+
+func TestAccProjectTagsResource_basic(t *testing.T) {
+	ctx := testutils.CreateTestContext(t)
+
+	projectName := acctest.RandomWithPrefix("test-project")
+	projectResourceName := projecttestutils.CreateProjectResourceName("test")
+	tagsResourceName := projecttestutils.CreateProjectTagsResourceName("test")
+
+	var projectID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectTagsConfigBasic(testDependencyTrack, projectName, []string{"tag1", "tag2"}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testutils.TestAccCheckGetResourceID(projectResourceName, &projectID),
+					projecttestutils.TestAccCheckProjectHasExpectedTags(ctx, testDependencyTrack, projectResourceName, []string{"tag1", "tag2"}),
+					resource.TestCheckResourceAttrPtr(tagsResourceName, "project_id", &projectID),
+					resource.TestCheckResourceAttr(tagsResourceName, "tags.#", "2"),
+					resource.TestCheckTypeSetElemAttr(tagsResourceName, "tags.*", "tag1"),
+					resource.TestCheckTypeSetElemAttr(tagsResourceName, "tags.*", "tag2"),
+				),
+			},
+			{
+				ResourceName:      tagsResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccProjectTagsConfigBasic(testDependencyTrack, projectName, []string{"tag2", "tag3"}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					projecttestutils.TestAccCheckProjectHasExpectedTags(ctx, testDependencyTrack, projectResourceName, []string{"tag2", "tag3"}),
+					resource.TestCheckResourceAttr(tagsResourceName, "tags.#", "2"),
+					resource.TestCheckTypeSetElemAttr(tagsResourceName, "tags.*", "tag2"),
+					resource.TestCheckTypeSetElemAttr(tagsResourceName, "tags.*", "tag3"),
+				),
+			},
+			{
+				Config: testAccProjectTagsConfigNoTags(testDependencyTrack, projectName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					projecttestutils.TestAccCheckProjectHasExpectedTags(ctx, testDependencyTrack, projectResourceName, []string{}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccProjectTagsResource_drift(t *testing.T) {
+	ctx := testutils.CreateTestContext(t)
+
+	projectName := acctest.RandomWithPrefix("test-project")
+	projectResourceName := projecttestutils.CreateProjectResourceName("test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Tags added outside of the resource are removed
+				Config: testAccProjectTagsConfigBasic(testDependencyTrack, projectName, []string{"tag1"}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					projecttestutils.TestAccCheckProjectHasExpectedTags(ctx, testDependencyTrack, projectResourceName, []string{"tag1"}),
+					testAccProjectTagsAddTagOutsideTerraform(ctx, projectResourceName, "extra"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccProjectTagsConfigBasic(testDependencyTrack, projectName, []string{"tag1"}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					projecttestutils.TestAccCheckProjectHasExpectedTags(ctx, testDependencyTrack, projectResourceName, []string{"tag1"}),
+				),
+			},
+		},
+	})
+}
+
+func testAccProjectTagsAddTagOutsideTerraform(ctx context.Context, projectResourceName, tag string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		project, err := projecttestutils.FindProjectByResourceName(ctx, testDependencyTrack, state, projectResourceName)
+		if err != nil {
+			return err
+		}
+		if project == nil {
+			return fmt.Errorf("project for resource %s does not exist in Dependency-Track", projectResourceName)
+		}
+
+		project.Tags = append(project.Tags, dtrack.Tag{Name: tag})
+
+		_, err = testDependencyTrack.Client.Project.Update(ctx, *project)
+		if err != nil {
+			return fmt.Errorf("failed to add tag to project for resource %s: %w", projectResourceName, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccProjectTagsConfigNoTags(testDependencyTrack *testutils.TestDependencyTrack, projectName string) string {
+	return testDependencyTrack.AddProviderConfiguration(
+		fmt.Sprintf(`
+resource "dependencytrack_project" "test" {
+	name        = %[1]q
+	classifier  = "APPLICATION"
+}
+`,
+			projectName,
+		),
+	)
+}
+
+func testAccProjectTagsConfigBasic(testDependencyTrack *testutils.TestDependencyTrack, projectName string, tags []string) string {
+	return testutils.ComposeConfigs(
+		testAccProjectTagsConfigNoTags(testDependencyTrack, projectName),
+		fmt.Sprintf(`
+resource "dependencytrack_project_tags" "test" {
+	project_id = dependencytrack_project.test.id
+	tags       = [%[1]s]
+}
+`,
+			testutils.QuoteList(tags),
+		),
+	)
+}
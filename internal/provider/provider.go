@@ -5,28 +5,72 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"os"
+	"strings"
+
 	dtrack "github.com/futurice/dependency-track-client-go"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/httpclient"
 	"github.com/futurice/terraform-provider-dependencytrack/internal/provider/aclmapping"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/provider/analysis"
 	"github.com/futurice/terraform-provider-dependencytrack/internal/provider/configproperty"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/provider/ldapgroups"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/provider/ldapmapping"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/provider/license"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/provider/licensegroup"
 	"github.com/futurice/terraform-provider-dependencytrack/internal/provider/notificationpublisher"
 	"github.com/futurice/terraform-provider-dependencytrack/internal/provider/notificationrule"
 	"github.com/futurice/terraform-provider-dependencytrack/internal/provider/notificationruleproject"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/provider/oidcgroup"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/provider/oidcgroupmapping"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/provider/policy"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/provider/policycondition"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/provider/policyproject"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/provider/policytag"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/provider/policyviolation"
 	"github.com/futurice/terraform-provider-dependencytrack/internal/provider/project"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/provider/projectproperty"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/provider/projecttags"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/provider/repository"
 	"github.com/futurice/terraform-provider-dependencytrack/internal/provider/team"
 	"github.com/futurice/terraform-provider-dependencytrack/internal/provider/teamapikey"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/provider/teammembers"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/provider/teammembership"
 	"github.com/futurice/terraform-provider-dependencytrack/internal/provider/teampermission"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/provider/user"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/provider/userpermission"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/provider/violationanalysis"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/provider/vulnerability"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/providerdata"
+	"github.com/hashicorp/terraform-plugin-framework-validators/providervalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+//nolint:gosec // These are just environment variable names, not actual credentials
+const (
+	hostEnvVarName        = "DEPENDENCYTRACK_HOST"
+	apiKeyEnvVarName      = "DEPENDENCYTRACK_API_KEY"
+	bearerTokenEnvVarName = "DEPENDENCYTRACK_BEARER_TOKEN"
+	usernameEnvVarName    = "DEPENDENCYTRACK_USERNAME"
+	passwordEnvVarName    = "DEPENDENCYTRACK_PASSWORD"
+
+	// fileEnvVarSuffix is appended to an environment variable name to get the name of a variable pointing to a file
+	// containing the value, e.g. for secrets mounted into the file system.
+	fileEnvVarSuffix = "_FILE"
 )
 
 // Ensure DependencyTrackProvider satisfies various provider interfaces.
 var _ provider.Provider = &DependencyTrackProvider{}
 var _ provider.ProviderWithFunctions = &DependencyTrackProvider{}
+var _ provider.ProviderWithConfigValidators = &DependencyTrackProvider{}
 
 // DependencyTrackProvider defines the provider implementation.
 type DependencyTrackProvider struct {
@@ -38,8 +82,27 @@ type DependencyTrackProvider struct {
 
 // DependencyTrackProviderModel describes the provider data model.
 type DependencyTrackProviderModel struct {
-	Host   types.String `tfsdk:"host"`
-	APIKey types.String `tfsdk:"api_key"`
+	Host        types.String `tfsdk:"host"`
+	APIKey      types.String `tfsdk:"api_key"`
+	BearerToken types.String `tfsdk:"bearer_token"`
+	Username    types.String `tfsdk:"username"`
+	Password    types.String `tfsdk:"password"`
+
+	CACertPEM          types.String `tfsdk:"ca_cert_pem"`
+	CACertFile         types.String `tfsdk:"ca_cert_file"`
+	ClientCert         types.String `tfsdk:"client_cert"`
+	ClientKey          types.String `tfsdk:"client_key"`
+	InsecureSkipVerify types.Bool   `tfsdk:"insecure_skip_verify"`
+	ProxyURL           types.String `tfsdk:"proxy_url"`
+	RequestTimeout     types.String `tfsdk:"request_timeout"`
+
+	MaxRetries   types.Int64  `tfsdk:"max_retries"`
+	RetryWaitMin types.String `tfsdk:"retry_wait_min"`
+	RetryWaitMax types.String `tfsdk:"retry_wait_max"`
+
+	Debug types.Bool `tfsdk:"debug"`
+
+	DefaultTags types.Set `tfsdk:"default_tags"`
 }
 
 func (p *DependencyTrackProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -51,16 +114,104 @@ func (p *DependencyTrackProvider) Schema(ctx context.Context, req provider.Schem
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"host": schema.StringAttribute{
-				Required: true,
+				MarkdownDescription: "URL of the Dependency-Track API server. Can also be set with the `DEPENDENCYTRACK_HOST` environment variable, or read from the file named by `DEPENDENCYTRACK_HOST_FILE`.",
+				Optional:            true,
 			},
 			"api_key": schema.StringAttribute{
-				Required:  true,
-				Sensitive: true,
+				MarkdownDescription: "API key for the Dependency-Track API server. Conflicts with `bearer_token` and `username`. Can also be set with the `DEPENDENCYTRACK_API_KEY` environment variable, or read from the file named by `DEPENDENCYTRACK_API_KEY_FILE`.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"bearer_token": schema.StringAttribute{
+				MarkdownDescription: "Pre-issued bearer token (JWT) for the Dependency-Track API server. Conflicts with `api_key` and `username`. Can also be set with the `DEPENDENCYTRACK_BEARER_TOKEN` environment variable, or read from the file named by `DEPENDENCYTRACK_BEARER_TOKEN_FILE`.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"username": schema.StringAttribute{
+				MarkdownDescription: "Username of a managed user to log in as. The provider exchanges `username` and `password` for a bearer token. Conflicts with `api_key` and `bearer_token`. Can also be set with the `DEPENDENCYTRACK_USERNAME` environment variable, or read from the file named by `DEPENDENCYTRACK_USERNAME_FILE`.",
+				Optional:            true,
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "Password of the user given in `username`. Can also be set with the `DEPENDENCYTRACK_PASSWORD` environment variable, or read from the file named by `DEPENDENCYTRACK_PASSWORD_FILE`.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"ca_cert_pem": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded CA certificates to trust in addition to the system certificates when connecting to the Dependency-Track API server, e.g. for a private CA. Conflicts with `ca_cert_file`.",
+				Optional:            true,
+			},
+			"ca_cert_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a file containing PEM encoded CA certificates to trust in addition to the system certificates. Conflicts with `ca_cert_pem`.",
+				Optional:            true,
+			},
+			"client_cert": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded client certificate for mutual TLS. Requires `client_key`.",
+				Optional:            true,
+			},
+			"client_key": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded private key of the client certificate given in `client_cert`.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				MarkdownDescription: "Skip the verification of the server certificate. Only use this for testing, e.g. against lab instances with self-signed certificates. Defaults to `false`.",
+				Optional:            true,
+			},
+			"proxy_url": schema.StringAttribute{
+				MarkdownDescription: "URL of the HTTP proxy to use. Defaults to the proxy given in the `HTTPS_PROXY`, `HTTP_PROXY` and `NO_PROXY` environment variables.",
+				Optional:            true,
+			},
+			"request_timeout": schema.StringAttribute{
+				MarkdownDescription: "Time limit for a single attempt of a request to the Dependency-Track API server, as a duration such as `30s` or `2m`. Defaults to `30s`.",
+				Optional:            true,
+			},
+			"max_retries": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of times a request is retried after a transient failure, i.e. a connection error or a `429`, `502`, `503` or `504` response. Only requests which are safe to repeat, such as reads and deletions, are retried. Set to `0` to disable retries. Defaults to `3`.",
+				Optional:            true,
+			},
+			"retry_wait_min": schema.StringAttribute{
+				MarkdownDescription: "Minimum time to wait before retrying a request, as a duration such as `1s`. The wait grows exponentially with jitter for each retry. A `Retry-After` header in the response takes precedence, up to `retry_wait_max`. Defaults to `1s`.",
+				Optional:            true,
+			},
+			"retry_wait_max": schema.StringAttribute{
+				MarkdownDescription: "Maximum time to wait before retrying a request, as a duration such as `30s`, also when the server asks for a longer wait with `Retry-After`. Defaults to `30s`.",
+				Optional:            true,
+			},
+			"debug": schema.BoolAttribute{
+				MarkdownDescription: "Include the request and response bodies in the HTTP log. The HTTP traffic is logged at the `DEBUG` level of the `http` subsystem, so it is only shown when enabled with `TF_LOG`, `TF_LOG_PROVIDER` or `TF_LOG_PROVIDER_DEPENDENCYTRACK_HTTP`. API keys, tokens, passwords and encrypted config property values are masked. Defaults to `false`.",
+				Optional:            true,
+			},
+			"default_tags": schema.SetAttribute{
+				MarkdownDescription: "Tags added to all projects managed by the provider, e.g. to identify them as managed by Terraform.",
+				ElementType:         types.StringType,
+				Optional:            true,
 			},
 		},
 	}
 }
 
+func (p *DependencyTrackProvider) ConfigValidators(ctx context.Context) []provider.ConfigValidator {
+	return []provider.ConfigValidator{
+		providervalidator.Conflicting(
+			path.MatchRoot("api_key"),
+			path.MatchRoot("bearer_token"),
+			path.MatchRoot("username"),
+		),
+		providervalidator.RequiredTogether(
+			path.MatchRoot("username"),
+			path.MatchRoot("password"),
+		),
+		providervalidator.Conflicting(
+			path.MatchRoot("ca_cert_pem"),
+			path.MatchRoot("ca_cert_file"),
+		),
+		providervalidator.RequiredTogether(
+			path.MatchRoot("client_cert"),
+			path.MatchRoot("client_key"),
+		),
+	}
+}
+
 func (p *DependencyTrackProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
 	var data DependencyTrackProviderModel
 
@@ -70,6 +221,16 @@ func (p *DependencyTrackProvider) Configure(ctx context.Context, req provider.Co
 		return
 	}
 
+	// Values missing from the configuration can be given via the environment
+	var diags diag.Diagnostics
+	data.Host, diags = stringValueWithEnvFallback(data.Host, hostEnvVarName, path.Root("host"))
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(applyAuthenticationEnvFallback(&data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Configuration values are now available.
 	if data.Host.IsNull() {
 		resp.Diagnostics.AddAttributeError(path.Root("host"),
@@ -78,28 +239,59 @@ func (p *DependencyTrackProvider) Configure(ctx context.Context, req provider.Co
 		)
 	}
 
-	if data.APIKey.IsNull() {
-		resp.Diagnostics.AddAttributeError(path.Root("api_key"),
-			"Missing Dependency Track API Key",
-			"The provider cannot create the Dependency Track API client as there is a missing or empty value for the Dependency Track API key.",
-		)
+	resp.Diagnostics.Append(validateAuthentication(data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
+	httpConfig, diags := httpClientConfig(data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpClient, err := httpclient.New(httpConfig)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid TLS or transport settings", fmt.Sprintf("Unable to create the HTTP client for Dependency-Track: %s", err))
+		return
+	}
+
+	authOption, diags := authenticationOption(ctx, data, httpClient)
+	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	client, err := dtrack.NewClient(data.Host.ValueString(),
-		dtrack.WithAPIKey(data.APIKey.ValueString()),
-		dtrack.WithDebug(true),
+		dtrack.WithHttpClient(httpClient),
+		authOption,
 	)
 	if err != nil {
 		resp.Diagnostics.AddError("Dependency-Track Client creation failed", err.Error())
 		return
 	}
 
-	resp.DataSourceData = client
-	resp.ResourceData = client
+	var defaultTags []string
+	resp.Diagnostics.Append(data.DefaultTags.ElementsAs(ctx, &defaultTags, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	providerData := &providerdata.ProviderData{
+		Client:      client,
+		Cache:       providerdata.NewCache(),
+		DefaultTags: defaultTags,
+		Retry: providerdata.RetrySettings{
+			MaxRetries: httpConfig.MaxRetries,
+			WaitMin:    httpConfig.RetryWaitMin,
+			WaitMax:    httpConfig.RetryWaitMax,
+		},
+	}
+	resp.Diagnostics.Append(detectServerVersion(ctx, providerData)...)
+
+	resp.DataSourceData = providerData
+	resp.ResourceData = providerData
 }
 
 func (p *DependencyTrackProvider) Resources(ctx context.Context) []func() resource.Resource {
@@ -109,10 +301,39 @@ func (p *DependencyTrackProvider) Resources(ctx context.Context) []func() resour
 		teamapikey.NewTeamAPIKeyResource,
 		teampermission.NewTeamPermissionResource,
 		project.NewProjectResource,
+		projectproperty.NewProjectPropertyResource,
+		projecttags.NewProjectTagsResource,
 		aclmapping.NewACLMappingResource,
 		notificationrule.NewNotificationRuleResource,
 		notificationruleproject.NewNotificationRuleProjectResource,
 		notificationpublisher.NewNotificationPublisherResource,
+		policy.NewPolicyResource,
+		policycondition.NewPolicyConditionResource,
+		policyproject.NewPolicyProjectResource,
+		policytag.NewPolicyTagResource,
+		licensegroup.NewLicenseGroupResource,
+		license.NewLicenseResource,
+		user.NewManagedUserResource,
+		user.NewLDAPUserResource,
+		user.NewOIDCUserResource,
+		teammembership.NewTeamMembershipResource,
+		teammembers.NewTeamMembersResource,
+		userpermission.NewUserPermissionResource,
+		oidcgroup.NewOIDCGroupResource,
+		oidcgroupmapping.NewOIDCGroupMappingResource,
+		ldapmapping.NewLDAPMappingResource,
+		analysis.NewAnalysisResource,
+		violationanalysis.NewViolationAnalysisResource,
+		vulnerability.NewVulnerabilityResource,
+		repository.NewRepositoryResource,
+		configproperty.NewDefectDojoIntegrationResource,
+		configproperty.NewFortifySSCIntegrationResource,
+		configproperty.NewKennaIntegrationResource,
+		configproperty.NewNVDVulnerabilitySourceResource,
+		configproperty.NewGitHubVulnerabilitySourceResource,
+		configproperty.NewOSVVulnerabilitySourceResource,
+		configproperty.NewSnykAnalyzerResource,
+		configproperty.NewOSSIndexAnalyzerResource,
 	}
 }
 
@@ -120,6 +341,9 @@ func (p *DependencyTrackProvider) DataSources(ctx context.Context) []func() data
 	return []func() datasource.DataSource{
 		team.NewTeamDataSource,
 		notificationpublisher.NewNotificationPublisherDataSource,
+		license.NewLicenseDataSource,
+		ldapgroups.NewLDAPGroupsDataSource,
+		policyviolation.NewPolicyViolationDataSource,
 	}
 }
 
@@ -127,6 +351,66 @@ func (p *DependencyTrackProvider) Functions(ctx context.Context) []func() functi
 	return []func() function.Function{}
 }
 
+// stringValueWithEnvFallback returns the value as is if it was set in the configuration. Otherwise, the value of the
+// environment variable envVarName is returned, or the contents of the file named by the environment variable
+// envVarName + fileEnvVarSuffix, in this order. If none of them are set, a null value is returned.
+func stringValueWithEnvFallback(value types.String, envVarName string, attributePath path.Path) (types.String, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if !value.IsNull() {
+		return value, diags
+	}
+
+	if envValue := os.Getenv(envVarName); envValue != "" {
+		return types.StringValue(envValue), diags
+	}
+
+	fileEnvVarName := envVarName + fileEnvVarSuffix
+	if fileName := os.Getenv(fileEnvVarName); fileName != "" {
+		content, err := os.ReadFile(fileName) //nolint:gosec // Reading a file chosen by the user is the intended behaviour
+		if err != nil {
+			diags.AddAttributeError(attributePath,
+				"Unable to read value from file",
+				fmt.Sprintf("The file [%s] given in the %s environment variable could not be read: %s", fileName, fileEnvVarName, err),
+			)
+			return types.StringNull(), diags
+		}
+
+		return types.StringValue(strings.TrimSpace(string(content))), diags
+	}
+
+	return types.StringNull(), diags
+}
+
+// detectServerVersion sets the server version of providerData. Failing to detect it is only a warning, in which case
+// resources assume the server supports all features.
+func detectServerVersion(ctx context.Context, providerData *providerdata.ProviderData) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	about, err := providerData.Client.About.Get(ctx)
+	if err != nil {
+		diags.AddWarning("Unable to detect Dependency-Track version",
+			fmt.Sprintf("Unable to get the version of the Dependency-Track API server, assuming it supports all features. Got error: %s", err),
+		)
+		return diags
+	}
+
+	serverVersion, err := providerdata.ParseServerVersion(about.Version)
+	if err != nil {
+		diags.AddWarning("Unable to detect Dependency-Track version",
+			fmt.Sprintf("Unable to parse the version [%s] of the Dependency-Track API server, assuming it supports all features. Got error: %s", about.Version, err),
+		)
+		return diags
+	}
+
+	tflog.Info(ctx, "Detected Dependency-Track version", map[string]interface{}{
+		"version": serverVersion.String(),
+	})
+	providerData.ServerVersion = serverVersion
+
+	return diags
+}
+
 func New(version string) func() provider.Provider {
 	return func() provider.Provider {
 		return &DependencyTrackProvider{
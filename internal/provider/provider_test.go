@@ -0,0 +1,684 @@
+// Copyright (c) 2024 Futurice Oy
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/futurice/terraform-provider-dependencytrack/internal/providerdata"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+//nolint:gosec // Not real credentials
+const (
+	testAPIKey        = "odt_testkey"
+	testBearerToken   = "eyJhbGciOiJIUzI1NiJ9"
+	testServerVersion = "4.13.2"
+)
+
+// testHost is the URL of a fake Dependency-Track API server, see testAPIHandler.
+var testHost string
+
+func TestMain(m *testing.M) {
+	server := httptest.NewServer(testAPIHandler())
+	testHost = server.URL
+
+	code := m.Run()
+
+	server.Close()
+	os.Exit(code)
+}
+
+func TestConfigure_fromConfig(t *testing.T) {
+	clearProviderEnv(t)
+
+	resp := configureTestProvider(t, map[string]string{
+		"host":    testHost,
+		"api_key": testAPIKey,
+	})
+
+	assertConfigured(t, resp)
+}
+
+func TestConfigure_fromEnv(t *testing.T) {
+	clearProviderEnv(t)
+	t.Setenv(hostEnvVarName, testHost)
+	t.Setenv(apiKeyEnvVarName, testAPIKey)
+
+	resp := configureTestProvider(t, map[string]string{})
+
+	assertConfigured(t, resp)
+}
+
+func TestConfigure_fromFile(t *testing.T) {
+	clearProviderEnv(t)
+	t.Setenv(hostEnvVarName+fileEnvVarSuffix, writeTestFile(t, "host", testHost+"\n"))
+	t.Setenv(apiKeyEnvVarName+fileEnvVarSuffix, writeTestFile(t, "api_key", testAPIKey+"\n"))
+
+	resp := configureTestProvider(t, map[string]string{})
+
+	assertConfigured(t, resp)
+}
+
+func TestConfigure_configTakesPrecedenceOverEnv(t *testing.T) {
+	clearProviderEnv(t)
+	// The files do not exist, so trying to read them would fail the configuration
+	t.Setenv(hostEnvVarName+fileEnvVarSuffix, filepath.Join(t.TempDir(), "missing"))
+	t.Setenv(apiKeyEnvVarName+fileEnvVarSuffix, filepath.Join(t.TempDir(), "missing"))
+
+	resp := configureTestProvider(t, map[string]string{
+		"host":    testHost,
+		"api_key": testAPIKey,
+	})
+
+	assertConfigured(t, resp)
+}
+
+func TestConfigure_envTakesPrecedenceOverFile(t *testing.T) {
+	clearProviderEnv(t)
+	t.Setenv(hostEnvVarName, testHost)
+	t.Setenv(apiKeyEnvVarName, testAPIKey)
+	// The files do not exist, so trying to read them would fail the configuration
+	t.Setenv(hostEnvVarName+fileEnvVarSuffix, filepath.Join(t.TempDir(), "missing"))
+	t.Setenv(apiKeyEnvVarName+fileEnvVarSuffix, filepath.Join(t.TempDir(), "missing"))
+
+	resp := configureTestProvider(t, map[string]string{})
+
+	assertConfigured(t, resp)
+}
+
+func TestConfigure_missingFile(t *testing.T) {
+	clearProviderEnv(t)
+	t.Setenv(hostEnvVarName, testHost)
+	t.Setenv(apiKeyEnvVarName+fileEnvVarSuffix, filepath.Join(t.TempDir(), "missing"))
+
+	resp := configureTestProvider(t, map[string]string{})
+
+	if !resp.Diagnostics.HasError() {
+		t.Fatalf("Error expected, but received none")
+	}
+
+	if !containsAttributeError(resp.Diagnostics, path.Root("api_key"), "Unable to read value from file") {
+		t.Errorf("Diags do not contain the expected attribute error: %v", resp.Diagnostics)
+	}
+}
+
+func TestConfigure_missingValues(t *testing.T) {
+	clearProviderEnv(t)
+
+	resp := configureTestProvider(t, map[string]string{})
+
+	if resp.ResourceData != nil || resp.DataSourceData != nil {
+		t.Errorf("Provider data set even though the configuration is invalid")
+	}
+
+	if !containsAttributeError(resp.Diagnostics, path.Root("host"), "Missing Dependency Track API Host") {
+		t.Errorf("Diags do not contain the expected host error: %v", resp.Diagnostics)
+	}
+
+	if !containsAttributeError(resp.Diagnostics, path.Root("api_key"), "Missing Dependency Track API Key") {
+		t.Errorf("Diags do not contain the expected API key error: %v", resp.Diagnostics)
+	}
+}
+
+func TestConfigure_bearerToken(t *testing.T) {
+	clearProviderEnv(t)
+
+	resp := configureTestProvider(t, map[string]string{
+		"host":         testHost,
+		"bearer_token": testBearerToken,
+	})
+
+	assertConfigured(t, resp)
+}
+
+func TestConfigure_login(t *testing.T) {
+	clearProviderEnv(t)
+
+	loggedIn := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost && r.URL.Path == "/api/v1/user/login" &&
+			r.FormValue("username") == "admin" && r.FormValue("password") == "secret" {
+			loggedIn = true
+			_, _ = w.Write([]byte(testBearerToken))
+			return
+		}
+
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	resp := configureTestProvider(t, map[string]string{
+		"host":     server.URL,
+		"username": "admin",
+		"password": "secret",
+	})
+
+	assertConfigured(t, resp)
+
+	if !loggedIn {
+		t.Errorf("Provider did not log in with the given username and password")
+	}
+}
+
+func TestConfigure_loginFailed(t *testing.T) {
+	clearProviderEnv(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	resp := configureTestProvider(t, map[string]string{
+		"host":     server.URL,
+		"username": "admin",
+		"password": "wrong",
+	})
+
+	if !containsError(resp.Diagnostics, "Dependency-Track login failed") {
+		t.Errorf("Diags do not contain the expected login error: %v", resp.Diagnostics)
+	}
+}
+
+func TestConfigure_configAuthenticationIgnoresEnv(t *testing.T) {
+	clearProviderEnv(t)
+	t.Setenv(apiKeyEnvVarName, testAPIKey)
+
+	resp := configureTestProvider(t, map[string]string{
+		"host":         testHost,
+		"bearer_token": testBearerToken,
+	})
+
+	assertConfigured(t, resp)
+}
+
+func TestConfigure_conflictingEnvCredentials(t *testing.T) {
+	clearProviderEnv(t)
+	t.Setenv(hostEnvVarName, testHost)
+	t.Setenv(apiKeyEnvVarName, testAPIKey)
+	t.Setenv(bearerTokenEnvVarName, testBearerToken)
+
+	resp := configureTestProvider(t, map[string]string{})
+
+	if !containsError(resp.Diagnostics, "Conflicting Dependency Track Credentials") {
+		t.Errorf("Diags do not contain the expected conflict error: %v", resp.Diagnostics)
+	}
+}
+
+func TestConfigure_missingPassword(t *testing.T) {
+	clearProviderEnv(t)
+	t.Setenv(hostEnvVarName, testHost)
+	t.Setenv(usernameEnvVarName, "admin")
+
+	resp := configureTestProvider(t, map[string]string{})
+
+	if !containsAttributeError(resp.Diagnostics, path.Root("password"), "Missing Dependency Track Password") {
+		t.Errorf("Diags do not contain the expected password error: %v", resp.Diagnostics)
+	}
+}
+
+func TestConfigure_caCertPEM(t *testing.T) {
+	clearProviderEnv(t)
+
+	server := newTestLoginServer(t)
+
+	resp := configureTestProvider(t, map[string]string{
+		"host":        server.URL,
+		"username":    "admin",
+		"password":    "secret",
+		"ca_cert_pem": encodeTestCertificate(server),
+	})
+
+	assertConfigured(t, resp)
+}
+
+func TestConfigure_caCertFile(t *testing.T) {
+	clearProviderEnv(t)
+
+	server := newTestLoginServer(t)
+
+	resp := configureTestProvider(t, map[string]string{
+		"host":         server.URL,
+		"username":     "admin",
+		"password":     "secret",
+		"ca_cert_file": writeTestFile(t, "ca.pem", encodeTestCertificate(server)),
+	})
+
+	assertConfigured(t, resp)
+}
+
+func TestConfigure_untrustedServer(t *testing.T) {
+	clearProviderEnv(t)
+
+	server := newTestLoginServer(t)
+
+	resp := configureTestProvider(t, map[string]string{
+		"host":     server.URL,
+		"username": "admin",
+		"password": "secret",
+	})
+
+	if !containsError(resp.Diagnostics, "Dependency-Track login failed") {
+		t.Errorf("Diags do not contain the expected login error: %v", resp.Diagnostics)
+	}
+}
+
+func TestConfigure_insecureSkipVerify(t *testing.T) {
+	clearProviderEnv(t)
+
+	server := newTestLoginServer(t)
+
+	resp := configureTestProvider(t, map[string]string{
+		"host":                 server.URL,
+		"username":             "admin",
+		"password":             "secret",
+		"insecure_skip_verify": "true",
+	})
+
+	assertConfigured(t, resp)
+}
+
+func TestConfigure_missingCACertFile(t *testing.T) {
+	clearProviderEnv(t)
+
+	resp := configureTestProvider(t, map[string]string{
+		"host":         testHost,
+		"api_key":      testAPIKey,
+		"ca_cert_file": filepath.Join(t.TempDir(), "missing"),
+	})
+
+	if !containsAttributeError(resp.Diagnostics, path.Root("ca_cert_file"), "Unable to read CA certificate file") {
+		t.Errorf("Diags do not contain the expected attribute error: %v", resp.Diagnostics)
+	}
+}
+
+func TestConfigure_invalidCACert(t *testing.T) {
+	clearProviderEnv(t)
+
+	resp := configureTestProvider(t, map[string]string{
+		"host":        testHost,
+		"api_key":     testAPIKey,
+		"ca_cert_pem": "not a certificate",
+	})
+
+	if !containsError(resp.Diagnostics, "Invalid TLS or transport settings") {
+		t.Errorf("Diags do not contain the expected error: %v", resp.Diagnostics)
+	}
+}
+
+func TestConfigure_requestTimeout(t *testing.T) {
+	clearProviderEnv(t)
+
+	resp := configureTestProvider(t, map[string]string{
+		"host":            testHost,
+		"api_key":         testAPIKey,
+		"request_timeout": "2m",
+	})
+
+	assertConfigured(t, resp)
+}
+
+func TestConfigure_invalidRequestTimeout(t *testing.T) {
+	clearProviderEnv(t)
+
+	resp := configureTestProvider(t, map[string]string{
+		"host":            testHost,
+		"api_key":         testAPIKey,
+		"request_timeout": "soon",
+	})
+
+	if !containsAttributeError(resp.Diagnostics, path.Root("request_timeout"), "Invalid duration") {
+		t.Errorf("Diags do not contain the expected attribute error: %v", resp.Diagnostics)
+	}
+}
+
+func TestConfigure_retrySettings(t *testing.T) {
+	clearProviderEnv(t)
+
+	resp := configureTestProvider(t, map[string]string{
+		"host":           testHost,
+		"api_key":        testAPIKey,
+		"max_retries":    "5",
+		"retry_wait_min": "500ms",
+		"retry_wait_max": "1m",
+	})
+
+	assertConfigured(t, resp)
+}
+
+func TestConfigure_invalidRetrySettings(t *testing.T) {
+	clearProviderEnv(t)
+
+	resp := configureTestProvider(t, map[string]string{
+		"host":           testHost,
+		"api_key":        testAPIKey,
+		"max_retries":    "-1",
+		"retry_wait_max": "forever",
+	})
+
+	if !containsAttributeError(resp.Diagnostics, path.Root("max_retries"), "Invalid maximum number of retries") {
+		t.Errorf("Diags do not contain the expected max_retries error: %v", resp.Diagnostics)
+	}
+
+	if !containsAttributeError(resp.Diagnostics, path.Root("retry_wait_max"), "Invalid duration") {
+		t.Errorf("Diags do not contain the expected retry_wait_max error: %v", resp.Diagnostics)
+	}
+}
+
+func TestConfigure_retryWaitMinGreaterThanMax(t *testing.T) {
+	clearProviderEnv(t)
+
+	resp := configureTestProvider(t, map[string]string{
+		"host":           testHost,
+		"api_key":        testAPIKey,
+		"retry_wait_min": "1m",
+		"retry_wait_max": "1s",
+	})
+
+	if !containsAttributeError(resp.Diagnostics, path.Root("retry_wait_min"), "Invalid retry wait") {
+		t.Errorf("Diags do not contain the expected attribute error: %v", resp.Diagnostics)
+	}
+}
+
+func TestConfigure_debug(t *testing.T) {
+	clearProviderEnv(t)
+
+	resp := configureTestProvider(t, map[string]string{
+		"host":    testHost,
+		"api_key": testAPIKey,
+		"debug":   "true",
+	})
+
+	assertConfigured(t, resp)
+}
+
+func TestConfigure_serverVersion(t *testing.T) {
+	clearProviderEnv(t)
+
+	resp := configureTestProvider(t, map[string]string{
+		"host":    testHost,
+		"api_key": testAPIKey,
+	})
+
+	assertConfigured(t, resp)
+
+	providerData, ok := resp.ResourceData.(*providerdata.ProviderData)
+	if !ok {
+		t.Fatalf("Unexpected provider data type: %T", resp.ResourceData)
+	}
+
+	if providerData.ServerVersion == nil || providerData.ServerVersion.String() != testServerVersion {
+		t.Errorf("Expected server version %s, got %v", testServerVersion, providerData.ServerVersion)
+	}
+}
+
+func TestConfigure_unknownServerVersion(t *testing.T) {
+	clearProviderEnv(t)
+
+	server := httptest.NewServer(http.NotFoundHandler())
+	defer server.Close()
+
+	resp := configureTestProvider(t, map[string]string{
+		"host":    server.URL,
+		"api_key": testAPIKey,
+	})
+
+	assertConfigured(t, resp)
+
+	if resp.Diagnostics.WarningsCount() != 1 || resp.Diagnostics.Warnings()[0].Summary() != "Unable to detect Dependency-Track version" {
+		t.Errorf("Diags do not contain the expected warning: %v", resp.Diagnostics)
+	}
+
+	providerData, ok := resp.ResourceData.(*providerdata.ProviderData)
+	if !ok {
+		t.Fatalf("Unexpected provider data type: %T", resp.ResourceData)
+	}
+
+	if providerData.ServerVersion != nil {
+		t.Errorf("Expected unknown server version, got %s", providerData.ServerVersion)
+	}
+}
+
+func TestConfigValidators_conflictingCACerts(t *testing.T) {
+	diags := validateTestProviderConfig(t, map[string]string{
+		"host":         testHost,
+		"api_key":      testAPIKey,
+		"ca_cert_pem":  "-----BEGIN CERTIFICATE-----",
+		"ca_cert_file": "ca.pem",
+	})
+
+	if !diags.HasError() {
+		t.Errorf("Error expected, but received none")
+	}
+}
+
+func TestConfigValidators_clientCertWithoutKey(t *testing.T) {
+	diags := validateTestProviderConfig(t, map[string]string{
+		"host":        testHost,
+		"api_key":     testAPIKey,
+		"client_cert": "-----BEGIN CERTIFICATE-----",
+	})
+
+	if !diags.HasError() {
+		t.Errorf("Error expected, but received none")
+	}
+}
+
+func TestConfigValidators_conflictingAuthentication(t *testing.T) {
+	diags := validateTestProviderConfig(t, map[string]string{
+		"host":         testHost,
+		"api_key":      testAPIKey,
+		"bearer_token": testBearerToken,
+	})
+
+	if !diags.HasError() {
+		t.Errorf("Error expected, but received none")
+	}
+}
+
+func TestConfigValidators_usernameWithoutPassword(t *testing.T) {
+	diags := validateTestProviderConfig(t, map[string]string{
+		"host":     testHost,
+		"username": "admin",
+	})
+
+	if !diags.HasError() {
+		t.Errorf("Error expected, but received none")
+	}
+}
+
+func TestConfigValidators_valid(t *testing.T) {
+	diags := validateTestProviderConfig(t, map[string]string{
+		"host":     testHost,
+		"username": "admin",
+		"password": "secret",
+	})
+
+	if diags.HasError() {
+		t.Errorf("Unexpected error: %v", diags)
+	}
+}
+
+// configureTestProvider runs Configure on a new provider instance with the given attribute values set in the
+// configuration. Boolean attributes are set to true for "true", and numbers are parsed from the string. All other attributes are left null.
+func configureTestProvider(t *testing.T, config map[string]string) provider.ConfigureResponse {
+	t.Helper()
+
+	ctx := context.Background()
+	p := New("test")()
+
+	req := provider.ConfigureRequest{
+		Config: newTestProviderConfig(t, p, config),
+	}
+	resp := provider.ConfigureResponse{}
+
+	p.Configure(ctx, req, &resp)
+
+	return resp
+}
+
+// validateTestProviderConfig runs the provider config validators against the given configuration.
+func validateTestProviderConfig(t *testing.T, config map[string]string) diag.Diagnostics {
+	t.Helper()
+
+	ctx := context.Background()
+	p := New("test")()
+
+	providerWithValidators, ok := p.(provider.ProviderWithConfigValidators)
+	if !ok {
+		t.Fatalf("Provider does not have config validators")
+	}
+
+	req := provider.ValidateConfigRequest{
+		Config: newTestProviderConfig(t, p, config),
+	}
+
+	var diags diag.Diagnostics
+	for _, validator := range providerWithValidators.ConfigValidators(ctx) {
+		resp := provider.ValidateConfigResponse{}
+		validator.ValidateProvider(ctx, req, &resp)
+		diags.Append(resp.Diagnostics...)
+	}
+
+	return diags
+}
+
+func newTestProviderConfig(t *testing.T, p provider.Provider, config map[string]string) tfsdk.Config {
+	t.Helper()
+
+	ctx := context.Background()
+
+	schemaResp := provider.SchemaResponse{}
+	p.Schema(ctx, provider.SchemaRequest{}, &schemaResp)
+	if schemaResp.Diagnostics.HasError() {
+		t.Fatalf("Unexpected schema error: %v", schemaResp.Diagnostics)
+	}
+
+	objectType, ok := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	if !ok {
+		t.Fatalf("Provider schema is not an object")
+	}
+
+	values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attributeType := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(attributeType, nil)
+	}
+	for name, value := range config {
+		switch attributeType := objectType.AttributeTypes[name]; {
+		case attributeType.Is(tftypes.Bool):
+			values[name] = tftypes.NewValue(tftypes.Bool, value == "true")
+			continue
+		case attributeType.Is(tftypes.Number):
+			number, ok := new(big.Float).SetString(value)
+			if !ok {
+				t.Fatalf("Invalid number [%s] for attribute %s", value, name)
+			}
+			values[name] = tftypes.NewValue(tftypes.Number, number)
+			continue
+		}
+
+		values[name] = tftypes.NewValue(tftypes.String, value)
+	}
+
+	return tfsdk.Config{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(objectType, values),
+	}
+}
+
+// clearProviderEnv makes sure the provider environment variables of the host do not affect the test.
+func clearProviderEnv(t *testing.T) {
+	t.Helper()
+
+	for _, envVarName := range []string{hostEnvVarName, apiKeyEnvVarName, bearerTokenEnvVarName, usernameEnvVarName, passwordEnvVarName} {
+		t.Setenv(envVarName, "")
+		t.Setenv(envVarName+fileEnvVarSuffix, "")
+	}
+}
+
+// testAPIHandler fakes the parts of the Dependency-Track API used by Configure: the version, and the login of
+// admin:secret.
+func testAPIHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/version":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"application":"Dependency-Track","version":"` + testServerVersion + `"}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v1/user/login" &&
+			r.FormValue("username") == "admin" && r.FormValue("password") == "secret":
+			_, _ = w.Write([]byte(testBearerToken))
+		default:
+			w.WriteHeader(http.StatusUnauthorized)
+		}
+	})
+}
+
+// newTestLoginServer starts a TLS server with a self-signed certificate serving testAPIHandler.
+func newTestLoginServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewTLSServer(testAPIHandler())
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func encodeTestCertificate(server *httptest.Server) string {
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}))
+}
+
+func writeTestFile(t *testing.T, name, content string) string {
+	t.Helper()
+
+	fileName := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(fileName, []byte(content), 0o600); err != nil {
+		t.Fatalf("Failed to write test file: %v", err)
+	}
+
+	return fileName
+}
+
+func assertConfigured(t *testing.T, resp provider.ConfigureResponse) {
+	t.Helper()
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Unexpected error: %v", resp.Diagnostics)
+	}
+
+	if resp.ResourceData == nil || resp.DataSourceData == nil {
+		t.Errorf("Provider data not set")
+	}
+}
+
+func containsAttributeError(diags diag.Diagnostics, attributePath path.Path, summary string) bool {
+	for _, d := range diags.Errors() {
+		withPath, ok := d.(diag.DiagnosticWithPath)
+		if ok && withPath.Path().Equal(attributePath) && d.Summary() == summary {
+			return true
+		}
+	}
+
+	return false
+}
+
+func containsError(diags diag.Diagnostics, summary string) bool {
+	for _, d := range diags.Errors() {
+		if d.Summary() == summary {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,370 @@
+// Copyright (c) 2024 Futurice Oy
+// SPDX-License-Identifier: MPL-2.0
+
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	dtrack "github.com/futurice/dependency-track-client-go"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/providerdata"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/utils"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &RepositoryResource{}
+var _ resource.ResourceWithImportState = &RepositoryResource{}
+var _ resource.ResourceWithModifyPlan = &RepositoryResource{}
+
+var repositoryTypes = []string{
+	"CARGO",
+	"COMPOSER",
+	"CPAN",
+	"GEM",
+	"GITHUB",
+	"GO_MODULES",
+	"HACKAGE",
+	"HEX",
+	"MAVEN",
+	"NIXPKGS",
+	"NPM",
+	"NUGET",
+	"PYPI",
+}
+
+func NewRepositoryResource() resource.Resource {
+	return &RepositoryResource{}
+}
+
+// RepositoryResource defines the resource implementation.
+type RepositoryResource struct {
+	providerdata.ResourceBase
+}
+
+// RepositoryResourceModel describes the resource data model.
+type RepositoryResourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	Type            types.String `tfsdk:"type"`
+	Identifier      types.String `tfsdk:"identifier"`
+	URL             types.String `tfsdk:"url"`
+	ResolutionOrder types.Int64  `tfsdk:"resolution_order"`
+	Enabled         types.Bool   `tfsdk:"enabled"`
+	Internal        types.Bool   `tfsdk:"internal"`
+	Username        types.String `tfsdk:"username"`
+	Password        types.String `tfsdk:"password"`
+}
+
+func (r *RepositoryResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_repository"
+}
+
+func (r *RepositoryResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Package repository used for analysing whether components are outdated, e.g. an internal Maven or npm registry",
+
+		Attributes: map[string]schema.Attribute{
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Type of the repository. Must be one of the following values: [" + strings.Join(repositoryTypes, ", ") + "]",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(repositoryTypes...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"identifier": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the repository. Must be unique among the repositories of the same type.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"url": schema.StringAttribute{
+				MarkdownDescription: "URL of the repository",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"resolution_order": schema.Int64Attribute{
+				MarkdownDescription: "Order in which the repositories of the same type are queried, starting from the lowest",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the repository is used. Default is true.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"internal": schema.BoolAttribute{
+				MarkdownDescription: "Whether the repository is internal. Internal repositories are only queried for internal components. Default is false.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"username": schema.StringAttribute{
+				MarkdownDescription: "Username for authenticating to the repository",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "Password or token for authenticating to the repository. Dependency-Track does not return the " +
+					"password, so changes made to it outside of Terraform are not detected.",
+				Optional:  true,
+				Sensitive: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Repository UUID",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// ModifyPlan checks that no other repository of the same type has the same identifier, so that the conflict is
+// reported already when planning.
+func (r *RepositoryResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.ProviderData == nil {
+		return
+	}
+
+	var plan RepositoryResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.Type.IsUnknown() || plan.Identifier.IsUnknown() {
+		return
+	}
+
+	// the repository itself is not a duplicate, also when it is being replaced
+	var ownID uuid.UUID
+	if !req.State.Raw.IsNull() {
+		var state RepositoryResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		var ownIDDiags diag.Diagnostics
+		ownID, ownIDDiags = utils.ParseAttributeUUID(state.ID.ValueString(), "id")
+		resp.Diagnostics.Append(ownIDDiags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	repositories, err := r.fetchRepositories(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get repositories, got error: %s", err))
+		return
+	}
+
+	for _, repository := range repositories {
+		if repository.UUID == ownID {
+			continue
+		}
+
+		if string(repository.Type) == plan.Type.ValueString() && repository.Identifier == plan.Identifier.ValueString() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("identifier"),
+				"Duplicate Repository Identifier",
+				fmt.Sprintf("A repository of the type %s with the identifier %s already exists in Dependency-Track (%s)",
+					plan.Type.ValueString(), plan.Identifier.ValueString(), repository.UUID),
+			)
+			return
+		}
+	}
+}
+
+func (r *RepositoryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan RepositoryResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dtRepository := TFRepositoryToDTRepository(plan)
+
+	respRepository, err := r.Client.Repository.Create(ctx, dtRepository)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create repository, got error: %s", err))
+		return
+	}
+
+	// password is not returned by the server
+	state := DTRepositoryToTFRepository(respRepository, plan.Password)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *RepositoryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state RepositoryResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	repositoryID, repositoryIDDiags := utils.ParseAttributeUUID(state.ID.ValueString(), "id")
+	resp.Diagnostics.Append(repositoryIDDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	respRepository, err := r.findRepository(ctx, repositoryID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read repository, got error: %s", err))
+		return
+	}
+
+	if respRepository == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state = DTRepositoryToTFRepository(*respRepository, state.Password)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *RepositoryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan RepositoryResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	repositoryID, repositoryIDDiags := utils.ParseAttributeUUID(plan.ID.ValueString(), "id")
+	resp.Diagnostics.Append(repositoryIDDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dtRepository := TFRepositoryToDTRepository(plan)
+	dtRepository.UUID = repositoryID
+
+	respRepository, err := r.Client.Repository.Update(ctx, dtRepository)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update repository, got error: %s", err))
+		return
+	}
+
+	state := DTRepositoryToTFRepository(respRepository, plan.Password)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *RepositoryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state RepositoryResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	repositoryID, repositoryIDDiags := utils.ParseAttributeUUID(state.ID.ValueString(), "id")
+	resp.Diagnostics.Append(repositoryIDDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.Client.Repository.Delete(ctx, repositoryID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete repository, got error: %s", err))
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *RepositoryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *RepositoryResource) fetchRepositories(ctx context.Context) ([]dtrack.Repository, error) {
+	return dtrack.FetchAll(func(po dtrack.PageOptions) (dtrack.Page[dtrack.Repository], error) {
+		return r.Client.Repository.GetAll(ctx, po)
+	})
+}
+
+// findRepository fetches the repository with the given UUID, since Dependency-Track has no API for getting a single
+// repository. Returns nil if there is no such repository.
+func (r *RepositoryResource) findRepository(ctx context.Context, repositoryID uuid.UUID) (*dtrack.Repository, error) {
+	repositories, err := r.fetchRepositories(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, repository := range repositories {
+		if repository.UUID == repositoryID {
+			return &repository, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func TFRepositoryToDTRepository(tfRepository RepositoryResourceModel) dtrack.Repository {
+	return dtrack.Repository{
+		Type:                   dtrack.RepositoryType(tfRepository.Type.ValueString()),
+		Identifier:             tfRepository.Identifier.ValueString(),
+		URL:                    tfRepository.URL.ValueString(),
+		ResolutionOrder:        int(tfRepository.ResolutionOrder.ValueInt64()),
+		Enabled:                tfRepository.Enabled.ValueBool(),
+		Internal:               tfRepository.Internal.ValueBool(),
+		AuthenticationRequired: !tfRepository.Username.IsNull() || !tfRepository.Password.IsNull(),
+		Username:               tfRepository.Username.ValueString(),
+		Password:               tfRepository.Password.ValueString(),
+	}
+}
+
+// DTRepositoryToTFRepository converts the repository returned by the server. The password is not returned, so the
+// given password is used instead as long as the repository requires authentication.
+func DTRepositoryToTFRepository(dtRepository dtrack.Repository, password types.String) RepositoryResourceModel {
+	tfRepository := RepositoryResourceModel{
+		ID:              types.StringValue(dtRepository.UUID.String()),
+		Type:            types.StringValue(string(dtRepository.Type)),
+		Identifier:      types.StringValue(dtRepository.Identifier),
+		URL:             types.StringValue(dtRepository.URL),
+		ResolutionOrder: types.Int64Value(int64(dtRepository.ResolutionOrder)),
+		Enabled:         types.BoolValue(dtRepository.Enabled),
+		Internal:        types.BoolValue(dtRepository.Internal),
+		Username:        types.StringNull(),
+		Password:        types.StringNull(),
+	}
+
+	if dtRepository.AuthenticationRequired {
+		if dtRepository.Username != "" {
+			tfRepository.Username = types.StringValue(dtRepository.Username)
+		}
+		tfRepository.Password = password
+	}
+
+	return tfRepository
+}
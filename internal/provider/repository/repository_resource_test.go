@@ -0,0 +1,184 @@
+// Copyright (c) 2024 Futurice Oy
+// SPDX-License-Identifier: MPL-2.0
+
+package repository_test
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	dtrack "github.com/futurice/dependency-track-client-go"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/testutils"
+	repositorytestutils "github.com/futurice/terraform-provider-dependencytrack/internal/testutils/repository"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+var testDependencyTrack *testutils.TestDependencyTrack
+
+func TestMain(m *testing.M) {
+	if os.Getenv(resource.EnvTfAcc) != "" {
+		var cleanup func()
+		testDependencyTrack, cleanup = testutils.InitTestDependencyTrack()
+		defer cleanup()
+	}
+
+	m.Run()
+}
+
+func TestAccRepositoryResource_basic(t *testing.T) {
+	ctx := testutils.CreateTestContext(t)
+
+	identifier := acctest.RandomWithPrefix("test-repository")
+	otherIdentifier := acctest.RandomWithPrefix("other-test-repository")
+	repositoryResourceName := repositorytestutils.CreateRepositoryResourceName("test")
+
+	testRepository := dtrack.Repository{
+		Type:                   "MAVEN",
+		Identifier:             identifier,
+		URL:                    "https://maven.example.com/releases",
+		ResolutionOrder:        10,
+		Enabled:                true,
+		Internal:               true,
+		AuthenticationRequired: true,
+		Username:               "reader",
+	}
+
+	testUpdatedRepository := testRepository
+	testUpdatedRepository.Identifier = otherIdentifier
+	testUpdatedRepository.URL = "https://maven.example.com/all"
+	testUpdatedRepository.ResolutionOrder = 11
+	testUpdatedRepository.Enabled = false
+	testUpdatedRepository.Internal = false
+	testUpdatedRepository.AuthenticationRequired = false
+	testUpdatedRepository.Username = ""
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRepositoryConfigBasic(testDependencyTrack, testRepository, "secret"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					repositorytestutils.TestAccCheckRepositoryExistsAndHasExpectedData(ctx, testDependencyTrack, repositoryResourceName, testRepository),
+					resource.TestCheckResourceAttrSet(repositoryResourceName, "id"),
+					resource.TestCheckResourceAttr(repositoryResourceName, "type", "MAVEN"),
+					resource.TestCheckResourceAttr(repositoryResourceName, "identifier", identifier),
+					resource.TestCheckResourceAttr(repositoryResourceName, "url", testRepository.URL),
+					resource.TestCheckResourceAttr(repositoryResourceName, "resolution_order", "10"),
+					resource.TestCheckResourceAttr(repositoryResourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(repositoryResourceName, "internal", "true"),
+					resource.TestCheckResourceAttr(repositoryResourceName, "username", "reader"),
+					resource.TestCheckResourceAttr(repositoryResourceName, "password", "secret"),
+				),
+			},
+			{
+				ResourceName:      repositoryResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// password is not returned by the server
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+			{
+				Config: testAccRepositoryConfigNoAuthentication(testDependencyTrack, testUpdatedRepository),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					repositorytestutils.TestAccCheckRepositoryExistsAndHasExpectedData(ctx, testDependencyTrack, repositoryResourceName, testUpdatedRepository),
+					resource.TestCheckResourceAttr(repositoryResourceName, "identifier", otherIdentifier),
+					resource.TestCheckResourceAttr(repositoryResourceName, "url", testUpdatedRepository.URL),
+					resource.TestCheckResourceAttr(repositoryResourceName, "resolution_order", "11"),
+					resource.TestCheckResourceAttr(repositoryResourceName, "enabled", "false"),
+					resource.TestCheckResourceAttr(repositoryResourceName, "internal", "false"),
+					resource.TestCheckNoResourceAttr(repositoryResourceName, "username"),
+					resource.TestCheckNoResourceAttr(repositoryResourceName, "password"),
+				),
+			},
+		},
+		CheckDestroy: func(state *terraform.State) error {
+			return repositorytestutils.TestAccCheckRepositoryDoesNotExists(ctx, testDependencyTrack, repositoryResourceName)(state)
+		},
+	})
+}
+
+func TestAccRepositoryResource_duplicateIdentifier(t *testing.T) {
+	testRepository := dtrack.Repository{
+		Type:            "NPM",
+		Identifier:      acctest.RandomWithPrefix("test-repository"),
+		URL:             "https://npm.example.com",
+		ResolutionOrder: 10,
+		Enabled:         true,
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRepositoryConfigNoAuthentication(testDependencyTrack, testRepository),
+			},
+			{
+				Config:      testAccRepositoryConfigDuplicate(testDependencyTrack, testRepository),
+				ExpectError: regexp.MustCompile("Duplicate Repository Identifier"),
+			},
+		},
+	})
+}
+
+func testAccRepositoryConfigBasic(testDependencyTrack *testutils.TestDependencyTrack, repository dtrack.Repository, password string) string {
+	return testDependencyTrack.AddProviderConfiguration(
+		fmt.Sprintf(`
+resource "dependencytrack_repository" "test" {
+	type             = %[1]q
+	identifier       = %[2]q
+	url              = %[3]q
+	resolution_order = %[4]d
+	enabled          = %[5]t
+	internal         = %[6]t
+	username         = %[7]q
+	password         = %[8]q
+}
+`,
+			repository.Type, repository.Identifier, repository.URL, repository.ResolutionOrder, repository.Enabled, repository.Internal,
+			repository.Username, password,
+		),
+	)
+}
+
+func testAccRepositoryConfigNoAuthentication(testDependencyTrack *testutils.TestDependencyTrack, repository dtrack.Repository) string {
+	return testDependencyTrack.AddProviderConfiguration(
+		testAccRepositoryConfigNoAuthenticationWithoutProvider(repository),
+	)
+}
+
+func testAccRepositoryConfigDuplicate(testDependencyTrack *testutils.TestDependencyTrack, repository dtrack.Repository) string {
+	return testDependencyTrack.AddProviderConfiguration(
+		testAccRepositoryConfigNoAuthenticationWithoutProvider(repository) +
+			fmt.Sprintf(`
+resource "dependencytrack_repository" "duplicate" {
+	type             = %[1]q
+	identifier       = %[2]q
+	url              = "https://other.example.com"
+	resolution_order = 20
+}
+`,
+				repository.Type, repository.Identifier,
+			),
+	)
+}
+
+func testAccRepositoryConfigNoAuthenticationWithoutProvider(repository dtrack.Repository) string {
+	return fmt.Sprintf(`
+resource "dependencytrack_repository" "test" {
+	type             = %[1]q
+	identifier       = %[2]q
+	url              = %[3]q
+	resolution_order = %[4]d
+	enabled          = %[5]t
+	internal         = %[6]t
+}
+`,
+		repository.Type, repository.Identifier, repository.URL, repository.ResolutionOrder, repository.Enabled, repository.Internal,
+	)
+}
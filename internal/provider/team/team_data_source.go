@@ -9,7 +9,7 @@ import (
 
 	"github.com/futurice/terraform-provider-dependencytrack/internal/utils"
 
-	dtrack "github.com/futurice/dependency-track-client-go"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/providerdata"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -24,19 +24,15 @@ func NewTeamDataSource() datasource.DataSource {
 
 // TeamDataSource defines the data source implementation.
 type TeamDataSource struct {
-	client *dtrack.Client
+	providerdata.DataSourceBase
 }
 
 // TeamDataSourceModel describes the data source data model.
 type TeamDataSourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Permissions types.Set    `tfsdk:"permissions"`
-	// Commented out for now pending developments in https://github.com/DependencyTrack/dependency-track/issues/4000
-	//   if the issue is fixed: this can be uncommented (here and below) and a test can be added for this attribute
-	//   if the issue is not fixed: one workaround would be to migrate this data source to use the endpoint that returns
-	//     all the teams
-	//MappedOIDCGroups types.Set    `tfsdk:"mapped_oidc_groups"`
+	ID               types.String `tfsdk:"id"`
+	Name             types.String `tfsdk:"name"`
+	Permissions      types.Set    `tfsdk:"permissions"`
+	MappedOIDCGroups types.Set    `tfsdk:"mapped_oidc_groups"`
 }
 
 func (d *TeamDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -61,35 +57,15 @@ func (d *TeamDataSource) Schema(ctx context.Context, req datasource.SchemaReques
 				MarkdownDescription: "Permissions given to the team",
 				Computed:            true,
 			},
-			// See TeamDataSourceModel.MappedOIDCGroups above
-			//"mapped_oidc_groups": schema.SetAttribute{
-			//	ElementType:         types.StringType,
-			//	MarkdownDescription: "OIDC groups mapped to the team",
-			//	Computed:            true,
-			//},
+			"mapped_oidc_groups": schema.SetAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "UUIDs of the OIDC groups mapped to the team",
+				Computed:            true,
+			},
 		},
 	}
 }
 
-func (d *TeamDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
-	if req.ProviderData == nil {
-		return
-	}
-
-	client, ok := req.ProviderData.(*dtrack.Client)
-
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *dtrack.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
-
-		return
-	}
-
-	d.client = client
-}
-
 func (d *TeamDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var model TeamDataSourceModel
 
@@ -104,13 +80,13 @@ func (d *TeamDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		return
 	}
 
-	team, err := d.client.Team.Get(ctx, teamID)
+	team, err := FetchTeamWithOIDCMappings(ctx, d.Client, teamID)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read team, got error: %s", err))
 		return
 	}
-
-	if resp.Diagnostics.HasError() {
+	if team == nil {
+		resp.Diagnostics.AddError("Client Error", "The team could not be found")
 		return
 	}
 
@@ -122,12 +98,11 @@ func (d *TeamDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 	}
 	model.Permissions, _ = types.SetValueFrom(ctx, types.StringType, tfPermissions)
 
-	// See TeamDataSourceModel.MappedOIDCGroups above
-	//tfOIDCGroups := make([]string, len(team.MappedOIDCGroups))
-	//for i, p := range team.MappedOIDCGroups {
-	//	tfOIDCGroups[i] = p.UUID.String()
-	//}
-	//model.MappedOIDCGroups, _ = types.SetValueFrom(ctx, types.StringType, tfOIDCGroups)
+	tfOIDCGroups := make([]string, len(team.MappedOIDCGroups))
+	for i, mapping := range team.MappedOIDCGroups {
+		tfOIDCGroups[i] = mapping.Group.UUID.String()
+	}
+	model.MappedOIDCGroups, _ = types.SetValueFrom(ctx, types.StringType, tfOIDCGroups)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
 }
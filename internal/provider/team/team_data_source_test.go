@@ -10,6 +10,7 @@ import (
 	"testing"
 
 	"github.com/futurice/terraform-provider-dependencytrack/internal/testutils"
+	oidctestutils "github.com/futurice/terraform-provider-dependencytrack/internal/testutils/oidc"
 	"github.com/futurice/terraform-provider-dependencytrack/internal/testutils/teamtestutils"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -73,6 +74,28 @@ func TestAccTeamDataSource_permissions(t *testing.T) {
 	})
 }
 
+func TestAccTeamDataSource_mappedOIDCGroups(t *testing.T) {
+	teamName := acctest.RandomWithPrefix("test-team")
+	groupName := acctest.RandomWithPrefix("test-oidc-group")
+
+	groupResourceName := oidctestutils.CreateOIDCGroupResourceName("test")
+	teamDataSourceName := teamtestutils.CreateTeamDataSourceName("test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTeamDataSourceConfigMappedOIDCGroups(testDependencyTrack, teamName, groupName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(teamDataSourceName, "mapped_oidc_groups.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(teamDataSourceName, "mapped_oidc_groups.*", groupResourceName, "id"),
+				),
+			},
+		},
+	})
+}
+
 func testAccTeamDataSourceConfigBasic(testDependencyTrack *testutils.TestDependencyTrack, teamName string) string {
 	return testDependencyTrack.AddProviderConfiguration(
 		fmt.Sprintf(`
@@ -137,3 +160,29 @@ data "dependencytrack_team" "test" {
 		),
 	)
 }
+
+func testAccTeamDataSourceConfigMappedOIDCGroups(testDependencyTrack *testutils.TestDependencyTrack, teamName, groupName string) string {
+	return testDependencyTrack.AddProviderConfiguration(
+		fmt.Sprintf(`
+resource "dependencytrack_team" "test" {
+	name        = %[1]q
+}
+
+resource "dependencytrack_oidc_group" "test" {
+	name        = %[2]q
+}
+
+resource "dependencytrack_oidc_group_mapping" "test" {
+	team_id     = dependencytrack_team.test.id
+	group_id    = dependencytrack_oidc_group.test.id
+}
+
+data "dependencytrack_team" "test" {
+	id          = dependencytrack_team.test.id
+	depends_on  = [dependencytrack_oidc_group_mapping.test]
+}
+`,
+			teamName, groupName,
+		),
+	)
+}
@@ -0,0 +1,31 @@
+// Copyright (c) 2024 Futurice Oy
+// SPDX-License-Identifier: MPL-2.0
+
+package team
+
+import (
+	"context"
+
+	dtrack "github.com/futurice/dependency-track-client-go"
+	"github.com/google/uuid"
+)
+
+// FetchTeamWithOIDCMappings returns the team including its mapped OIDC groups, or nil if there is no such team. The
+// endpoint for getting a single team does not return the mapped OIDC groups
+// (https://github.com/DependencyTrack/dependency-track/issues/4000), so the team is looked up from all teams instead.
+func FetchTeamWithOIDCMappings(ctx context.Context, client *dtrack.Client, teamID uuid.UUID) (*dtrack.Team, error) {
+	teams, err := dtrack.FetchAll(func(po dtrack.PageOptions) (dtrack.Page[dtrack.Team], error) {
+		return client.Team.GetAll(ctx, po)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, team := range teams {
+		if team.UUID == teamID {
+			return &team, nil
+		}
+	}
+
+	return nil, nil
+}
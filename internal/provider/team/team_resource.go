@@ -10,6 +10,7 @@ import (
 	"net/http"
 
 	dtrack "github.com/futurice/dependency-track-client-go"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/providerdata"
 	"github.com/futurice/terraform-provider-dependencytrack/internal/utils"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 
@@ -31,7 +32,7 @@ func NewTeamResource() resource.Resource {
 
 // TeamResource defines the resource implementation.
 type TeamResource struct {
-	client *dtrack.Client
+	providerdata.ResourceBase
 }
 
 // TeamResourceModel describes the resource data model.
@@ -64,25 +65,6 @@ func (r *TeamResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 	}
 }
 
-func (r *TeamResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if req.ProviderData == nil {
-		return
-	}
-
-	client, ok := req.ProviderData.(*dtrack.Client)
-
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *dtrack.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
-
-		return
-	}
-
-	r.client = client
-}
-
 func (r *TeamResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan, state TeamResourceModel
 
@@ -97,7 +79,7 @@ func (r *TeamResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
-	respTeam, err := r.client.Team.Create(ctx, dtTeam)
+	respTeam, err := r.Client.Team.Create(ctx, dtTeam)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create API key, got error: %s", err))
 		return
@@ -124,7 +106,7 @@ func (r *TeamResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	respTeam, err := r.client.Team.Get(ctx, teamID)
+	respTeam, err := r.Client.Team.Get(ctx, teamID)
 	if err != nil {
 		var apiErr *dtrack.APIError
 		if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
@@ -157,7 +139,7 @@ func (r *TeamResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
-	respTeam, err := r.client.Team.Update(ctx, dtTeam)
+	respTeam, err := r.Client.Team.Update(ctx, dtTeam)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update team, got error: %s", err))
 		return
@@ -183,7 +165,7 @@ func (r *TeamResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 
-	err := r.client.Team.Delete(ctx, dtTeam)
+	err := r.Client.Team.Delete(ctx, dtTeam)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete team, got error: %s", err))
 		return
@@ -11,6 +11,7 @@ import (
 	"github.com/futurice/terraform-provider-dependencytrack/internal/utils"
 
 	dtrack "github.com/futurice/dependency-track-client-go"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/providerdata"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -29,7 +30,7 @@ func NewTeamAPIKeyResource() resource.Resource {
 
 // TeamAPIKeyResource defines the resource implementation.
 type TeamAPIKeyResource struct {
-	client *dtrack.Client
+	providerdata.ResourceBase
 }
 
 // TeamAPIKeyResourceModel describes the resource data model.
@@ -71,25 +72,6 @@ func (r *TeamAPIKeyResource) Schema(ctx context.Context, req resource.SchemaRequ
 	}
 }
 
-func (r *TeamAPIKeyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if req.ProviderData == nil {
-		return
-	}
-
-	client, ok := req.ProviderData.(*dtrack.Client)
-
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *dtrack.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
-
-		return
-	}
-
-	r.client = client
-}
-
 func (r *TeamAPIKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan TeamAPIKeyResourceModel
 
@@ -104,7 +86,7 @@ func (r *TeamAPIKeyResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
-	apiKey, err := r.client.Team.GenerateAPIKey(ctx, teamID)
+	apiKey, err := r.Client.Team.GenerateAPIKey(ctx, teamID)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create API key, got error: %s", err))
 		return
@@ -125,7 +107,7 @@ func (r *TeamAPIKeyResource) Read(ctx context.Context, req resource.ReadRequest,
 	}
 
 	// NOTE: API only returns the API keys for the team when fetching all the teams
-	teams, err := r.client.Team.GetAll(ctx, dtrack.PageOptions{})
+	teams, err := r.Client.Team.GetAll(ctx, dtrack.PageOptions{})
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read team, got error: %s", err))
 		return
@@ -165,7 +147,7 @@ func (r *TeamAPIKeyResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
-	_, err := r.client.Team.DeleteAPIKey(ctx, state.PublicID.ValueString())
+	_, err := r.Client.Team.DeleteAPIKey(ctx, state.PublicID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete API key, got error: %s", err))
 		return
@@ -0,0 +1,327 @@
+// Copyright (c) 2024 Futurice Oy
+// SPDX-License-Identifier: MPL-2.0
+
+package teammembers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+
+	dtrack "github.com/futurice/dependency-track-client-go"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/provider/user"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/providerdata"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/utils"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &TeamMembersResource{}
+var _ resource.ResourceWithImportState = &TeamMembersResource{}
+
+func NewTeamMembersResource() resource.Resource {
+	return &TeamMembersResource{}
+}
+
+// TeamMembersResource defines the resource implementation.
+type TeamMembersResource struct {
+	providerdata.ResourceBase
+}
+
+// TeamMembersResourceModel describes the resource data model.
+type TeamMembersResourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	TeamID       types.String `tfsdk:"team_id"`
+	ManagedUsers types.Set    `tfsdk:"managed_users"`
+	LDAPUsers    types.Set    `tfsdk:"ldap_users"`
+	OIDCUsers    types.Set    `tfsdk:"oidc_users"`
+}
+
+// members are the usernames of the members of a team by user type.
+type members map[string][]string
+
+func (r *TeamMembersResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team_members"
+}
+
+func (r *TeamMembersResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	usersAttribute := func(description string) schema.SetAttribute {
+		return schema.SetAttribute{
+			MarkdownDescription: description + " Default is no users.",
+			ElementType:         types.StringType,
+			Optional:            true,
+			Computed:            true,
+			Default:             setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Members of a team, managed authoritatively, i.e. users not listed here are removed from the team. " +
+			"Do not use together with `dependencytrack_team_membership` for the same team. " +
+			"On destroy, the members listed here are removed from the team.",
+
+		Attributes: map[string]schema.Attribute{
+			"team_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the team",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"managed_users": usersAttribute("Usernames of the managed users in the team."),
+			"ldap_users":    usersAttribute("Usernames of the LDAP users in the team."),
+			"oidc_users":    usersAttribute("Usernames of the OIDC users in the team."),
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Team UUID, same as team_id",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *TeamMembersResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan TeamMembersResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	teamID, teamIDDiags := utils.ParseAttributeUUID(plan.TeamID.ValueString(), "team_id")
+	resp.Diagnostics.Append(teamIDDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, diags := r.setMembers(ctx, teamID, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *TeamMembersResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state TeamMembersResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	teamID, teamIDDiags := utils.ParseAttributeUUID(state.TeamID.ValueString(), "team_id")
+	resp.Diagnostics.Append(teamIDDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.Client.Team.Get(ctx, teamID)
+	if err != nil {
+		var apiErr *dtrack.APIError
+		if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read team, got error: %s", err))
+		return
+	}
+
+	currentMembers, err := r.fetchMembers(ctx, teamID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read team members, got error: %s", err))
+		return
+	}
+
+	state, diags := membersToTF(ctx, teamID, currentMembers)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *TeamMembersResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan TeamMembersResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// only the members can change via Update, team_id requires replacement
+	teamID, teamIDDiags := utils.ParseAttributeUUID(plan.TeamID.ValueString(), "team_id")
+	resp.Diagnostics.Append(teamIDDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, diags := r.setMembers(ctx, teamID, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *TeamMembersResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state TeamMembersResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	teamID, teamIDDiags := utils.ParseAttributeUUID(state.TeamID.ValueString(), "team_id")
+	resp.Diagnostics.Append(teamIDDiags...)
+
+	stateMembers, diags := membersFromTF(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, usernames := range stateMembers {
+		for _, username := range usernames {
+			_, err := r.Client.User.RemoveTeamFromUser(ctx, username, teamID)
+			if err != nil {
+				var apiErr *dtrack.APIError
+				if errors.As(err, &apiErr) && (apiErr.StatusCode == http.StatusNotFound || apiErr.StatusCode == http.StatusNotModified) {
+					continue // the user or the membership is already gone
+				}
+
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove user %s from team, got error: %s", username, err))
+				return
+			}
+		}
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *TeamMembersResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team_id"), req.ID)...)
+}
+
+// setMembers adds and removes members of the team so that its members are exactly the users listed in plan.
+func (r *TeamMembersResource) setMembers(ctx context.Context, teamID uuid.UUID, plan TeamMembersResourceModel) (TeamMembersResourceModel, diag.Diagnostics) {
+	plannedMembers, diags := membersFromTF(ctx, plan)
+	if diags.HasError() {
+		return TeamMembersResourceModel{}, diags
+	}
+
+	currentMembers, err := r.fetchMembers(ctx, teamID)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read team members, got error: %s", err))
+		return TeamMembersResourceModel{}, diags
+	}
+
+	for _, userType := range user.Types {
+		for _, username := range currentMembers[userType] {
+			if slices.Contains(plannedMembers[userType], username) {
+				continue
+			}
+
+			_, err := r.Client.User.RemoveTeamFromUser(ctx, username, teamID)
+			if err != nil {
+				diags.AddError("Client Error", fmt.Sprintf("Unable to remove user %s from team, got error: %s", username, err))
+				return TeamMembersResourceModel{}, diags
+			}
+		}
+
+		for _, username := range plannedMembers[userType] {
+			if slices.Contains(currentMembers[userType], username) {
+				continue
+			}
+
+			_, err := r.Client.User.AddTeamToUser(ctx, username, teamID)
+			if err != nil {
+				diags.AddError("Client Error", fmt.Sprintf("Unable to add user %s to team, got error: %s", username, err))
+				return TeamMembersResourceModel{}, diags
+			}
+		}
+	}
+
+	return membersToTF(ctx, teamID, plannedMembers)
+}
+
+// fetchMembers returns the usernames of the current members of the team by user type.
+func (r *TeamMembersResource) fetchMembers(ctx context.Context, teamID uuid.UUID) (members, error) {
+	teamMembers := make(members, len(user.Types))
+
+	for _, userType := range user.Types {
+		teamsOfUsers, err := user.FetchTeamsOfUsers(ctx, r.Client, userType)
+		if err != nil {
+			return nil, err
+		}
+
+		teamMembers[userType] = []string{}
+		for username, teams := range teamsOfUsers {
+			if slices.ContainsFunc(teams, func(team dtrack.Team) bool { return team.UUID == teamID }) {
+				teamMembers[userType] = append(teamMembers[userType], username)
+			}
+		}
+	}
+
+	return teamMembers, nil
+}
+
+func membersFromTF(ctx context.Context, model TeamMembersResourceModel) (members, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	tfMembers := map[string]types.Set{
+		user.TypeManaged: model.ManagedUsers,
+		user.TypeLDAP:    model.LDAPUsers,
+		user.TypeOIDC:    model.OIDCUsers,
+	}
+
+	teamMembers := make(members, len(tfMembers))
+	for userType, usernames := range tfMembers {
+		var dtUsernames []string
+		diags.Append(usernames.ElementsAs(ctx, &dtUsernames, false)...)
+		teamMembers[userType] = dtUsernames
+	}
+
+	return teamMembers, diags
+}
+
+func membersToTF(ctx context.Context, teamID uuid.UUID, teamMembers members) (TeamMembersResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	toSet := func(usernames []string) types.Set {
+		if usernames == nil {
+			usernames = []string{}
+		}
+
+		set, setDiags := types.SetValueFrom(ctx, types.StringType, usernames)
+		diags.Append(setDiags...)
+
+		return set
+	}
+
+	return TeamMembersResourceModel{
+		ID:           types.StringValue(teamID.String()),
+		TeamID:       types.StringValue(teamID.String()),
+		ManagedUsers: toSet(teamMembers[user.TypeManaged]),
+		LDAPUsers:    toSet(teamMembers[user.TypeLDAP]),
+		OIDCUsers:    toSet(teamMembers[user.TypeOIDC]),
+	}, diags
+}
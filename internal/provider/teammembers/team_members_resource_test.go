@@ -0,0 +1,162 @@
+// Copyright (c) 2024 Futurice Oy
+// SPDX-License-Identifier: MPL-2.0
+
+package teammembers_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/futurice/terraform-provider-dependencytrack/internal/testutils"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/testutils/teamtestutils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+var testDependencyTrack *testutils.TestDependencyTrack
+
+func TestMain(m *testing.M) {
+	if os.Getenv(resource.EnvTfAcc) != "" {
+		var cleanup func()
+		testDependencyTrack, cleanup = testutils.InitTestDependencyTrack()
+		defer cleanup()
+	}
+
+	m.Run()
+}
+
+func TestAccTeamMembersResource_basic(t *testing.T) {
+	ctx := testutils.CreateTestContext(t)
+
+	teamName := acctest.RandomWithPrefix("test-team")
+	usernamePrefix := acctest.RandomWithPrefix("test-user")
+	managedUsernames := []string{usernamePrefix + "-managed-1", usernamePrefix + "-managed-2"}
+	oidcUsernames := []string{usernamePrefix + "-oidc-1"}
+
+	teamResourceName := teamtestutils.CreateTeamResourceName("test")
+	membersResourceName := teamtestutils.CreateTeamMembersResourceName("test")
+
+	var teamID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTeamMembersConfigBasic(testDependencyTrack, teamName, managedUsernames, oidcUsernames, managedUsernames, oidcUsernames),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					teamtestutils.TestAccCheckTeamHasExpectedMembers(ctx, testDependencyTrack, teamResourceName, "MANAGED", managedUsernames),
+					teamtestutils.TestAccCheckTeamHasExpectedMembers(ctx, testDependencyTrack, teamResourceName, "OIDC", oidcUsernames),
+					testutils.TestAccCheckGetResourceID(teamResourceName, &teamID),
+					resource.TestCheckResourceAttrPtr(membersResourceName, "id", &teamID),
+					resource.TestCheckResourceAttrPtr(membersResourceName, "team_id", &teamID),
+					resource.TestCheckResourceAttr(membersResourceName, "managed_users.#", "2"),
+					resource.TestCheckResourceAttr(membersResourceName, "ldap_users.#", "0"),
+					resource.TestCheckResourceAttr(membersResourceName, "oidc_users.#", "1"),
+				),
+			},
+			{
+				ResourceName:      membersResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTeamMembersConfigBasic(testDependencyTrack, teamName, managedUsernames, oidcUsernames, managedUsernames[1:], []string{}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					teamtestutils.TestAccCheckTeamHasExpectedMembers(ctx, testDependencyTrack, teamResourceName, "MANAGED", managedUsernames[1:]),
+					teamtestutils.TestAccCheckTeamHasExpectedMembers(ctx, testDependencyTrack, teamResourceName, "OIDC", []string{}),
+					resource.TestCheckResourceAttr(membersResourceName, "managed_users.#", "1"),
+					resource.TestCheckResourceAttr(membersResourceName, "oidc_users.#", "0"),
+				),
+			},
+		},
+		// CheckDestroy is not practical here since the team is destroyed as well, and we can no longer query its members
+	})
+}
+
+func TestAccTeamMembersResource_drift(t *testing.T) {
+	ctx := testutils.CreateTestContext(t)
+
+	teamName := acctest.RandomWithPrefix("test-team")
+	usernamePrefix := acctest.RandomWithPrefix("test-user")
+	managedUsernames := []string{usernamePrefix + "-managed-1", usernamePrefix + "-managed-2"}
+
+	teamResourceName := teamtestutils.CreateTeamResourceName("test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// A user added to the team outside Terraform is detected, and removed by the next apply
+				Config: testAccTeamMembersConfigBasic(testDependencyTrack, teamName, managedUsernames, []string{}, managedUsernames[:1], []string{}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccTeamMembersAddOutsideTerraform(ctx, teamResourceName, managedUsernames[1]),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccTeamMembersConfigBasic(testDependencyTrack, teamName, managedUsernames, []string{}, managedUsernames[:1], []string{}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					teamtestutils.TestAccCheckTeamHasExpectedMembers(ctx, testDependencyTrack, teamResourceName, "MANAGED", managedUsernames[:1]),
+				),
+			},
+		},
+	})
+}
+
+func testAccTeamMembersAddOutsideTerraform(ctx context.Context, teamResourceName, username string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		teamID, err := testutils.GetResourceID(state, teamResourceName)
+		if err != nil {
+			return err
+		}
+
+		_, err = testDependencyTrack.Client.User.AddTeamToUser(ctx, username, teamID)
+		if err != nil {
+			return fmt.Errorf("failed to add user %s to team %s: %w", username, teamID, err)
+		}
+
+		return nil
+	}
+}
+
+// testAccTeamMembersConfigBasic creates the managed and OIDC users, and makes the given members of them the members
+// of the team.
+func testAccTeamMembersConfigBasic(testDependencyTrack *testutils.TestDependencyTrack, teamName string, managedUsernames, oidcUsernames, managedMembers, oidcMembers []string) string {
+	return testDependencyTrack.AddProviderConfiguration(
+		fmt.Sprintf(`
+resource "dependencytrack_team" "test" {
+	name = %[1]q
+}
+
+resource "dependencytrack_managed_user" "test" {
+	for_each = toset([%[2]s])
+
+	username = each.key
+	fullname = "Test User"
+	email    = "test.user@example.com"
+	password = "test-password"
+}
+
+resource "dependencytrack_oidc_user" "test" {
+	for_each = toset([%[3]s])
+
+	username = each.key
+}
+
+resource "dependencytrack_team_members" "test" {
+	team_id       = dependencytrack_team.test.id
+	managed_users = [%[4]s]
+	oidc_users    = [%[5]s]
+
+	depends_on = [dependencytrack_managed_user.test, dependencytrack_oidc_user.test]
+}
+`,
+			teamName, testutils.QuoteList(managedUsernames), testutils.QuoteList(oidcUsernames), testutils.QuoteList(managedMembers), testutils.QuoteList(oidcMembers),
+		),
+	)
+}
@@ -0,0 +1,202 @@
+// Copyright (c) 2024 Futurice Oy
+// SPDX-License-Identifier: MPL-2.0
+
+package teammembership
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	dtrack "github.com/futurice/dependency-track-client-go"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/provider/user"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/providerdata"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/utils"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &TeamMembershipResource{}
+var _ resource.ResourceWithImportState = &TeamMembershipResource{}
+
+func NewTeamMembershipResource() resource.Resource {
+	return &TeamMembershipResource{}
+}
+
+// TeamMembershipResource defines the resource implementation.
+type TeamMembershipResource struct {
+	providerdata.ResourceBase
+}
+
+// TeamMembershipResourceModel describes the resource data model.
+type TeamMembershipResourceModel struct {
+	ID       types.String `tfsdk:"id"`
+	TeamID   types.String `tfsdk:"team_id"`
+	Username types.String `tfsdk:"username"`
+	UserType types.String `tfsdk:"user_type"`
+}
+
+func (r *TeamMembershipResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team_membership"
+}
+
+func (r *TeamMembershipResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Membership of a user in a team. Do not use together with `dependencytrack_team_members` for the same team.",
+
+		Attributes: map[string]schema.Attribute{
+			"team_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the team",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"username": schema.StringAttribute{
+				MarkdownDescription: "Username of the user",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user_type": schema.StringAttribute{
+				MarkdownDescription: "Type of the user. Possible values: [" + strings.Join(user.Types, ", ") + "]. Default is " + user.TypeManaged + ".",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(user.TypeManaged),
+				Validators: []validator.String{
+					stringvalidator.OneOf(user.Types...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Synthetic membership ID in the form of team_id/user_type/username",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *TeamMembershipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan TeamMembershipResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	teamID, teamIDDiags := utils.ParseAttributeUUID(plan.TeamID.ValueString(), "team_id")
+	resp.Diagnostics.Append(teamIDDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	username := plan.Username.ValueString()
+
+	_, err := r.Client.User.AddTeamToUser(ctx, username, teamID)
+	if err != nil {
+		var apiErr *dtrack.APIError
+		if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotModified {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("The user '%s' is already a member of the team", username))
+			return
+		}
+
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add user to team, got error: %s", err))
+		return
+	}
+
+	plan.ID = types.StringValue(makeMembershipID(teamID, plan.UserType.ValueString(), username))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *TeamMembershipResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state TeamMembershipResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	teamID, teamIDDiags := utils.ParseAttributeUUID(state.TeamID.ValueString(), "team_id")
+	resp.Diagnostics.Append(teamIDDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	teamsOfUsers, err := user.FetchTeamsOfUsers(ctx, r.Client, state.UserType.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read team membership, got error: %s", err))
+		return
+	}
+
+	// Both the user and the team disappearing are seen as the membership disappearing
+	teams := teamsOfUsers[state.Username.ValueString()]
+	if !slices.ContainsFunc(teams, func(team dtrack.Team) bool { return team.UUID == teamID }) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.ID = types.StringValue(makeMembershipID(teamID, state.UserType.ValueString(), state.Username.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *TeamMembershipResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.AddError("Internal Error", "Team membership resource is immutable")
+}
+
+func (r *TeamMembershipResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state TeamMembershipResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	teamID, teamIDDiags := utils.ParseAttributeUUID(state.TeamID.ValueString(), "team_id")
+	resp.Diagnostics.Append(teamIDDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.Client.User.RemoveTeamFromUser(ctx, state.Username.ValueString(), teamID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove user from team, got error: %s", err))
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *TeamMembershipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// The username is last, as it is the only part that could contain slashes
+	parts := strings.SplitN(req.ID, "/", 3)
+	if len(parts) != 3 || !slices.Contains(user.Types, parts[1]) {
+		resp.Diagnostics.AddError("Invalid import ID", fmt.Sprintf("Expected ID in the format 'team_id/user_type/username', got [%s]", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_type"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("username"), parts[2])...)
+}
+
+func makeMembershipID(teamID uuid.UUID, userType, username string) string {
+	return fmt.Sprintf("%s/%s/%s", teamID.String(), userType, username)
+}
@@ -0,0 +1,170 @@
+// Copyright (c) 2024 Futurice Oy
+// SPDX-License-Identifier: MPL-2.0
+
+package teammembership_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/futurice/terraform-provider-dependencytrack/internal/testutils"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/testutils/teamtestutils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+var testDependencyTrack *testutils.TestDependencyTrack
+
+func TestMain(m *testing.M) {
+	if os.Getenv(resource.EnvTfAcc) != "" {
+		var cleanup func()
+		testDependencyTrack, cleanup = testutils.InitTestDependencyTrack()
+		defer cleanup()
+	}
+
+	m.Run()
+}
+
+func TestAccTeamMembershipResource_basic(t *testing.T) {
+	ctx := testutils.CreateTestContext(t)
+
+	teamName := acctest.RandomWithPrefix("test-team")
+	username := acctest.RandomWithPrefix("test-user")
+
+	teamResourceName := teamtestutils.CreateTeamResourceName("test")
+	membershipResourceName := teamtestutils.CreateTeamMembershipResourceName("test")
+
+	var teamID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTeamMembershipConfigManagedUser(testDependencyTrack, teamName, username),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					teamtestutils.TestAccCheckTeamHasExpectedMembers(ctx, testDependencyTrack, teamResourceName, "MANAGED", []string{username}),
+					testutils.TestAccCheckGetResourceID(teamResourceName, &teamID),
+					resource.TestCheckResourceAttrPtr(membershipResourceName, "team_id", &teamID),
+					resource.TestCheckResourceAttr(membershipResourceName, "username", username),
+					resource.TestCheckResourceAttr(membershipResourceName, "user_type", "MANAGED"),
+					resource.TestCheckResourceAttrSet(membershipResourceName, "id"),
+				),
+			},
+			{
+				ResourceName:      membershipResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTeamMembershipConfigOIDCUser(testDependencyTrack, teamName, username),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					teamtestutils.TestAccCheckTeamHasExpectedMembers(ctx, testDependencyTrack, teamResourceName, "MANAGED", []string{}),
+					teamtestutils.TestAccCheckTeamHasExpectedMembers(ctx, testDependencyTrack, teamResourceName, "OIDC", []string{username}),
+					resource.TestCheckResourceAttr(membershipResourceName, "user_type", "OIDC"),
+				),
+			},
+			{
+				Config: testAccTeamMembershipConfigNoMembership(testDependencyTrack, teamName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					teamtestutils.TestAccCheckTeamHasExpectedMembers(ctx, testDependencyTrack, teamResourceName, "OIDC", []string{}),
+				),
+			},
+		},
+		// CheckDestroy is not practical here since the team is destroyed as well, and we can no longer query its members
+	})
+}
+
+func TestAccTeamMembershipResource_disappears(t *testing.T) {
+	ctx := testutils.CreateTestContext(t)
+
+	teamName := acctest.RandomWithPrefix("test-team")
+	username := acctest.RandomWithPrefix("test-user")
+
+	teamResourceName := teamtestutils.CreateTeamResourceName("test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTeamMembershipConfigManagedUser(testDependencyTrack, teamName, username),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccTeamMembershipDeleteOutsideTerraform(ctx, teamResourceName, username),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccTeamMembershipDeleteOutsideTerraform(ctx context.Context, teamResourceName, username string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		teamID, err := testutils.GetResourceID(state, teamResourceName)
+		if err != nil {
+			return err
+		}
+
+		_, err = testDependencyTrack.Client.User.RemoveTeamFromUser(ctx, username, teamID)
+		if err != nil {
+			return fmt.Errorf("failed to remove user %s from team %s: %w", username, teamID, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccTeamMembershipConfigNoMembership(testDependencyTrack *testutils.TestDependencyTrack, teamName string) string {
+	return testDependencyTrack.AddProviderConfiguration(
+		fmt.Sprintf(`
+resource "dependencytrack_team" "test" {
+	name = %[1]q
+}
+`,
+			teamName,
+		),
+	)
+}
+
+func testAccTeamMembershipConfigManagedUser(testDependencyTrack *testutils.TestDependencyTrack, teamName, username string) string {
+	return testutils.ComposeConfigs(
+		testAccTeamMembershipConfigNoMembership(testDependencyTrack, teamName),
+		fmt.Sprintf(`
+resource "dependencytrack_managed_user" "test" {
+	username = %[1]q
+	fullname = "Test User"
+	email    = "test.user@example.com"
+	password = "test-password"
+}
+
+resource "dependencytrack_team_membership" "test" {
+	team_id  = dependencytrack_team.test.id
+	username = dependencytrack_managed_user.test.username
+}
+`,
+			username,
+		),
+	)
+}
+
+func testAccTeamMembershipConfigOIDCUser(testDependencyTrack *testutils.TestDependencyTrack, teamName, username string) string {
+	return testutils.ComposeConfigs(
+		testAccTeamMembershipConfigNoMembership(testDependencyTrack, teamName),
+		fmt.Sprintf(`
+resource "dependencytrack_oidc_user" "test" {
+	username = %[1]q
+}
+
+resource "dependencytrack_team_membership" "test" {
+	team_id   = dependencytrack_team.test.id
+	username  = dependencytrack_oidc_user.test.username
+	user_type = "OIDC"
+}
+`,
+			username,
+		),
+	)
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/google/uuid"
 
 	dtrack "github.com/futurice/dependency-track-client-go"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/providerdata"
 	"github.com/futurice/terraform-provider-dependencytrack/internal/utils"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -30,7 +31,7 @@ func NewTeamPermissionResource() resource.Resource {
 
 // TeamPermissionResource defines the resource implementation.
 type TeamPermissionResource struct {
-	client *dtrack.Client
+	providerdata.ResourceBase
 }
 
 // TeamPermissionResourceModel describes the resource data model.
@@ -65,25 +66,6 @@ func (r *TeamPermissionResource) Schema(ctx context.Context, req resource.Schema
 	}
 }
 
-func (r *TeamPermissionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if req.ProviderData == nil {
-		return
-	}
-
-	client, ok := req.ProviderData.(*dtrack.Client)
-
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *dtrack.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
-
-		return
-	}
-
-	r.client = client
-}
-
 func (r *TeamPermissionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan, state TeamPermissionResourceModel
 
@@ -102,7 +84,7 @@ func (r *TeamPermissionResource) Create(ctx context.Context, req resource.Create
 		Name: plan.Name.ValueString(),
 	}
 
-	_, err := r.client.Permission.AddPermissionToTeam(ctx, permission, teamID)
+	_, err := r.Client.Permission.AddPermissionToTeam(ctx, permission, teamID)
 	if err != nil {
 		var apiErr *dtrack.APIError
 		if errors.As(err, &apiErr) {
@@ -139,7 +121,7 @@ func (r *TeamPermissionResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
-	respTeam, err := r.client.Team.Get(ctx, teamID)
+	respTeam, err := r.Client.Team.Get(ctx, teamID)
 	if err != nil {
 		var apiErr *dtrack.APIError
 		if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
@@ -190,7 +172,7 @@ func (r *TeamPermissionResource) Update(ctx context.Context, req resource.Update
 		Name: plan.Name.ValueString(),
 	}
 
-	_, err := r.client.Permission.AddPermissionToTeam(ctx, newPermission, newTeamID)
+	_, err := r.Client.Permission.AddPermissionToTeam(ctx, newPermission, newTeamID)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create new permission, got error: %s", err))
 		return
@@ -200,7 +182,7 @@ func (r *TeamPermissionResource) Update(ctx context.Context, req resource.Update
 		Name: state.Name.ValueString(),
 	}
 
-	_, err = r.client.Permission.RemovePermissionFromTeam(ctx, oldPermission, oldTeamID)
+	_, err = r.Client.Permission.RemovePermissionFromTeam(ctx, oldPermission, oldTeamID)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete old permission, got error: %s", err))
 		return
@@ -231,7 +213,7 @@ func (r *TeamPermissionResource) Delete(ctx context.Context, req resource.Delete
 		Name: state.Name.ValueString(),
 	}
 
-	_, err := r.client.Permission.RemovePermissionFromTeam(ctx, permission, teamID)
+	_, err := r.Client.Permission.RemovePermissionFromTeam(ctx, permission, teamID)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete permission, got error: %s", err))
 		return
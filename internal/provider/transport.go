@@ -0,0 +1,107 @@
+// Copyright (c) 2024 Futurice Oy
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/futurice/terraform-provider-dependencytrack/internal/httpclient"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Defaults for the transport settings not set in the configuration.
+const (
+	defaultRequestTimeout = 30 * time.Second
+	defaultMaxRetries     = 3
+	defaultRetryWaitMin   = 1 * time.Second
+	defaultRetryWaitMax   = 30 * time.Second
+)
+
+// httpClientConfig returns the configuration of the HTTP client used to access the Dependency-Track API from the TLS
+// and transport settings in data.
+func httpClientConfig(data DependencyTrackProviderModel) (httpclient.Config, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	config := httpclient.Config{
+		CACertPEM:          data.CACertPEM.ValueString(),
+		ClientCertPEM:      data.ClientCert.ValueString(),
+		ClientKeyPEM:       data.ClientKey.ValueString(),
+		InsecureSkipVerify: data.InsecureSkipVerify.ValueBool(),
+		ProxyURL:           data.ProxyURL.ValueString(),
+		Timeout:            defaultRequestTimeout,
+		MaxRetries:         defaultMaxRetries,
+		RetryWaitMin:       defaultRetryWaitMin,
+		RetryWaitMax:       defaultRetryWaitMax,
+		LogBodies:          data.Debug.ValueBool(),
+	}
+
+	if !data.CACertFile.IsNull() {
+		content, err := os.ReadFile(data.CACertFile.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("ca_cert_file"),
+				"Unable to read CA certificate file",
+				fmt.Sprintf("The CA certificate file [%s] could not be read: %s", data.CACertFile.ValueString(), err),
+			)
+			return httpclient.Config{}, diags
+		}
+
+		config.CACertPEM = string(content)
+	}
+
+	if !data.MaxRetries.IsNull() {
+		if data.MaxRetries.ValueInt64() < 0 {
+			diags.AddAttributeError(path.Root("max_retries"),
+				"Invalid maximum number of retries",
+				fmt.Sprintf("The maximum number of retries [%d] must not be negative.", data.MaxRetries.ValueInt64()),
+			)
+		}
+
+		config.MaxRetries = int(data.MaxRetries.ValueInt64())
+	}
+
+	diags.Append(parseDuration(data.RequestTimeout, path.Root("request_timeout"), &config.Timeout)...)
+	diags.Append(parseDuration(data.RetryWaitMin, path.Root("retry_wait_min"), &config.RetryWaitMin)...)
+	diags.Append(parseDuration(data.RetryWaitMax, path.Root("retry_wait_max"), &config.RetryWaitMax)...)
+
+	if diags.HasError() {
+		return httpclient.Config{}, diags
+	}
+
+	if config.RetryWaitMin > config.RetryWaitMax {
+		diags.AddAttributeError(path.Root("retry_wait_min"),
+			"Invalid retry wait",
+			fmt.Sprintf("The minimum retry wait [%s] must not be greater than the maximum retry wait [%s].", config.RetryWaitMin, config.RetryWaitMax),
+		)
+		return httpclient.Config{}, diags
+	}
+
+	return config, diags
+}
+
+// parseDuration sets target to the duration given in value, unless value is null. Durations are given as strings
+// such as "30s" or "2m".
+func parseDuration(value types.String, attributePath path.Path, target *time.Duration) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if value.IsNull() {
+		return diags
+	}
+
+	duration, err := time.ParseDuration(value.ValueString())
+	if err != nil || duration < 0 {
+		diags.AddAttributeError(attributePath,
+			"Invalid duration",
+			fmt.Sprintf("The value [%s] must be a non-negative duration such as \"30s\" or \"2m\".", value.ValueString()),
+		)
+		return diags
+	}
+
+	*target = duration
+
+	return diags
+}
@@ -0,0 +1,159 @@
+// Copyright (c) 2024 Futurice Oy
+// SPDX-License-Identifier: MPL-2.0
+
+package user
+
+import (
+	"context"
+	"fmt"
+
+	dtrack "github.com/futurice/dependency-track-client-go"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/providerdata"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &LDAPUserResource{}
+var _ resource.ResourceWithImportState = &LDAPUserResource{}
+
+func NewLDAPUserResource() resource.Resource {
+	return &LDAPUserResource{}
+}
+
+// LDAPUserResource defines the resource implementation.
+type LDAPUserResource struct {
+	providerdata.ResourceBase
+}
+
+// LDAPUserResourceModel describes the resource data model.
+type LDAPUserResourceModel struct {
+	ID       types.String `tfsdk:"id"`
+	Username types.String `tfsdk:"username"`
+	DN       types.String `tfsdk:"dn"`
+	Email    types.String `tfsdk:"email"`
+}
+
+func (r *LDAPUserResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ldap_user"
+}
+
+func (r *LDAPUserResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "LDAP user. The other details of the user are synchronized from the LDAP server by Dependency-Track.",
+
+		Attributes: map[string]schema.Attribute{
+			"username": schema.StringAttribute{
+				MarkdownDescription: "Username of the user in the LDAP directory",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"dn": schema.StringAttribute{
+				MarkdownDescription: "Distinguished name of the user",
+				Computed:            true,
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "Email address of the user",
+				Computed:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Username of the user, same as username",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *LDAPUserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan LDAPUserResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	respUser, err := r.Client.User.CreateLDAP(ctx, dtrack.LDAPUser{Username: plan.Username.ValueString()})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create LDAP user, got error: %s", err))
+		return
+	}
+
+	state := DTLDAPUserToTFLDAPUser(respUser)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *LDAPUserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state LDAPUserResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	respUser, err := findUser(func(po dtrack.PageOptions) (dtrack.Page[dtrack.LDAPUser], error) {
+		return r.Client.User.GetAllLDAP(ctx, po)
+	}, func(user dtrack.LDAPUser) string { return user.Username }, state.Username.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read LDAP user, got error: %s", err))
+		return
+	}
+	if respUser == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state = DTLDAPUserToTFLDAPUser(*respUser)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *LDAPUserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.AddError("Internal Error", "LDAP user resource is immutable")
+}
+
+func (r *LDAPUserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state LDAPUserResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.Client.User.DeleteLDAP(ctx, dtrack.LDAPUser{Username: state.Username.ValueString()})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete LDAP user, got error: %s", err))
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// ImportState imports an LDAP user by its username.
+func (r *LDAPUserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("username"), req.ID)...)
+}
+
+func DTLDAPUserToTFLDAPUser(dtUser dtrack.LDAPUser) LDAPUserResourceModel {
+	return LDAPUserResourceModel{
+		ID:       types.StringValue(dtUser.Username),
+		Username: types.StringValue(dtUser.Username),
+		DN:       types.StringValue(dtUser.DN),
+		Email:    types.StringValue(dtUser.Email),
+	}
+}
@@ -0,0 +1,64 @@
+// Copyright (c) 2024 Futurice Oy
+// SPDX-License-Identifier: MPL-2.0
+
+package user_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/futurice/terraform-provider-dependencytrack/internal/testutils"
+	usertestutils "github.com/futurice/terraform-provider-dependencytrack/internal/testutils/user"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccLDAPUserResource_basic(t *testing.T) {
+	ctx := testutils.CreateTestContext(t)
+
+	userResourceName := usertestutils.CreateLDAPUserResourceName("test")
+
+	username := acctest.RandomWithPrefix("test-user")
+	otherUsername := acctest.RandomWithPrefix("other-test-user")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLDAPUserConfigBasic(testDependencyTrack, username),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					usertestutils.TestAccCheckLDAPUserExists(ctx, testDependencyTrack, username),
+					resource.TestCheckResourceAttr(userResourceName, "id", username),
+					resource.TestCheckResourceAttr(userResourceName, "username", username),
+				),
+			},
+			{
+				ResourceName:      userResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccLDAPUserConfigBasic(testDependencyTrack, otherUsername),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					usertestutils.TestAccCheckLDAPUserExists(ctx, testDependencyTrack, otherUsername),
+					usertestutils.TestAccCheckLDAPUserDoesNotExists(ctx, testDependencyTrack, username),
+					resource.TestCheckResourceAttr(userResourceName, "username", otherUsername),
+				),
+			},
+		},
+		CheckDestroy: usertestutils.TestAccCheckLDAPUserDoesNotExists(ctx, testDependencyTrack, otherUsername),
+	})
+}
+
+func testAccLDAPUserConfigBasic(testDependencyTrack *testutils.TestDependencyTrack, username string) string {
+	return testDependencyTrack.AddProviderConfiguration(
+		fmt.Sprintf(`
+resource "dependencytrack_ldap_user" "test" {
+	username = %[1]q
+}
+`,
+			username,
+		),
+	)
+}
@@ -0,0 +1,238 @@
+// Copyright (c) 2024 Futurice Oy
+// SPDX-License-Identifier: MPL-2.0
+
+package user
+
+import (
+	"context"
+	"fmt"
+
+	dtrack "github.com/futurice/dependency-track-client-go"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/providerdata"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ManagedUserResource{}
+var _ resource.ResourceWithImportState = &ManagedUserResource{}
+
+func NewManagedUserResource() resource.Resource {
+	return &ManagedUserResource{}
+}
+
+// ManagedUserResource defines the resource implementation.
+type ManagedUserResource struct {
+	providerdata.ResourceBase
+}
+
+// ManagedUserResourceModel describes the resource data model.
+type ManagedUserResourceModel struct {
+	ID                  types.String `tfsdk:"id"`
+	Username            types.String `tfsdk:"username"`
+	Fullname            types.String `tfsdk:"fullname"`
+	Email               types.String `tfsdk:"email"`
+	Password            types.String `tfsdk:"password"`
+	ForcePasswordChange types.Bool   `tfsdk:"force_password_change"`
+	NonExpiryPassword   types.Bool   `tfsdk:"non_expiry_password"`
+	Suspended           types.Bool   `tfsdk:"suspended"`
+}
+
+func (r *ManagedUserResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_managed_user"
+}
+
+func (r *ManagedUserResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Managed user, i.e. a user whose credentials are stored in Dependency-Track",
+
+		Attributes: map[string]schema.Attribute{
+			"username": schema.StringAttribute{
+				MarkdownDescription: "Username of the user",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"fullname": schema.StringAttribute{
+				MarkdownDescription: "Full name of the user",
+				Required:            true,
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "Email address of the user",
+				Required:            true,
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "Password of the user. Dependency-Track does not return the password, " +
+					"so changes made to it outside of Terraform are not detected.",
+				Required:  true,
+				Sensitive: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"force_password_change": schema.BoolAttribute{
+				MarkdownDescription: "Whether the user must change the password on the next login. Default is false.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"non_expiry_password": schema.BoolAttribute{
+				MarkdownDescription: "Whether the password of the user never expires. Default is false.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"suspended": schema.BoolAttribute{
+				MarkdownDescription: "Whether the user is suspended, i.e. can not log in. Default is false.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Username of the user, same as username",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ManagedUserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ManagedUserResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dtUser := TFManagedUserToDTManagedUser(plan)
+	dtUser.NewPassword = plan.Password.ValueString()
+	dtUser.ConfirmPassword = plan.Password.ValueString()
+
+	respUser, err := r.Client.User.CreateManaged(ctx, dtUser)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create managed user, got error: %s", err))
+		return
+	}
+
+	state := DTManagedUserToTFManagedUser(respUser, plan.Password)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ManagedUserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ManagedUserResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	respUser, err := findUser(func(po dtrack.PageOptions) (dtrack.Page[dtrack.ManagedUser], error) {
+		return r.Client.User.GetAllManaged(ctx, po)
+	}, func(user dtrack.ManagedUser) string { return user.Username }, state.Username.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read managed user, got error: %s", err))
+		return
+	}
+	if respUser == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state = DTManagedUserToTFManagedUser(*respUser, state.Password)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ManagedUserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state ManagedUserResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dtUser := TFManagedUserToDTManagedUser(plan)
+
+	// The password is only sent when it changes, as setting it resets the last password change time of the user
+	if !plan.Password.Equal(state.Password) {
+		dtUser.NewPassword = plan.Password.ValueString()
+		dtUser.ConfirmPassword = plan.Password.ValueString()
+	}
+
+	respUser, err := r.Client.User.UpdateManaged(ctx, dtUser)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update managed user, got error: %s", err))
+		return
+	}
+
+	state = DTManagedUserToTFManagedUser(respUser, plan.Password)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ManagedUserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ManagedUserResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.Client.User.DeleteManaged(ctx, dtrack.ManagedUser{Username: state.Username.ValueString()})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete managed user, got error: %s", err))
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// ImportState imports a managed user by its username. The password is not known after the import, so it is set on
+// the next apply.
+func (r *ManagedUserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("username"), req.ID)...)
+}
+
+// DTManagedUserToTFManagedUser converts the user to the model. The password is never returned by Dependency-Track, so
+// it is given separately.
+func DTManagedUserToTFManagedUser(dtUser dtrack.ManagedUser, password types.String) ManagedUserResourceModel {
+	return ManagedUserResourceModel{
+		ID:                  types.StringValue(dtUser.Username),
+		Username:            types.StringValue(dtUser.Username),
+		Fullname:            types.StringValue(dtUser.Fullname),
+		Email:               types.StringValue(dtUser.Email),
+		Password:            password,
+		ForcePasswordChange: types.BoolValue(dtUser.ForcePasswordChange),
+		NonExpiryPassword:   types.BoolValue(dtUser.NonExpiryPassword),
+		Suspended:           types.BoolValue(dtUser.Suspended),
+	}
+}
+
+// TFManagedUserToDTManagedUser converts the model to a user. The password is not included, see Create and Update.
+func TFManagedUserToDTManagedUser(tfUser ManagedUserResourceModel) dtrack.ManagedUser {
+	return dtrack.ManagedUser{
+		Username:            tfUser.Username.ValueString(),
+		Fullname:            tfUser.Fullname.ValueString(),
+		Email:               tfUser.Email.ValueString(),
+		ForcePasswordChange: tfUser.ForcePasswordChange.ValueBool(),
+		NonExpiryPassword:   tfUser.NonExpiryPassword.ValueBool(),
+		Suspended:           tfUser.Suspended.ValueBool(),
+	}
+}
@@ -0,0 +1,141 @@
+// Copyright (c) 2024 Futurice Oy
+// SPDX-License-Identifier: MPL-2.0
+
+package user_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	dtrack "github.com/futurice/dependency-track-client-go"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/testutils"
+	usertestutils "github.com/futurice/terraform-provider-dependencytrack/internal/testutils/user"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccManagedUserResource_basic(t *testing.T) {
+	ctx := testutils.CreateTestContext(t)
+
+	userResourceName := usertestutils.CreateManagedUserResourceName("test")
+
+	testUser := dtrack.ManagedUser{
+		Username: acctest.RandomWithPrefix("test-user"),
+		Fullname: "Test User",
+		Email:    "test.user@example.com",
+	}
+	testPassword := acctest.RandString(20)
+
+	testUpdatedUser := testUser
+	testUpdatedUser.Fullname = "Other Test User"
+	testUpdatedUser.Email = "other.test.user@example.com"
+	testUpdatedUser.NonExpiryPassword = true
+	testUpdatedPassword := acctest.RandString(20)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccManagedUserConfigBasic(testDependencyTrack, testUser, testPassword),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					usertestutils.TestAccCheckManagedUserExistsAndHasExpectedData(ctx, testDependencyTrack, testUser),
+					usertestutils.TestAccCheckManagedUserCanLogIn(ctx, testDependencyTrack, testUser.Username, testPassword),
+					resource.TestCheckResourceAttr(userResourceName, "id", testUser.Username),
+					resource.TestCheckResourceAttr(userResourceName, "username", testUser.Username),
+					resource.TestCheckResourceAttr(userResourceName, "fullname", testUser.Fullname),
+					resource.TestCheckResourceAttr(userResourceName, "email", testUser.Email),
+					resource.TestCheckResourceAttr(userResourceName, "password", testPassword),
+					resource.TestCheckResourceAttr(userResourceName, "force_password_change", "false"),
+					resource.TestCheckResourceAttr(userResourceName, "non_expiry_password", "false"),
+					resource.TestCheckResourceAttr(userResourceName, "suspended", "false"),
+				),
+			},
+			{
+				ResourceName:            userResourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+			{
+				Config: testAccManagedUserConfigBasic(testDependencyTrack, testUpdatedUser, testUpdatedPassword),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					usertestutils.TestAccCheckManagedUserExistsAndHasExpectedData(ctx, testDependencyTrack, testUpdatedUser),
+					usertestutils.TestAccCheckManagedUserCanLogIn(ctx, testDependencyTrack, testUpdatedUser.Username, testUpdatedPassword),
+					resource.TestCheckResourceAttr(userResourceName, "fullname", testUpdatedUser.Fullname),
+					resource.TestCheckResourceAttr(userResourceName, "email", testUpdatedUser.Email),
+					resource.TestCheckResourceAttr(userResourceName, "non_expiry_password", "true"),
+				),
+			},
+		},
+		CheckDestroy: usertestutils.TestAccCheckManagedUserDoesNotExists(ctx, testDependencyTrack, testUser.Username),
+	})
+}
+
+func TestAccManagedUserResource_suspended(t *testing.T) {
+	ctx := testutils.CreateTestContext(t)
+
+	userResourceName := usertestutils.CreateManagedUserResourceName("test")
+
+	testUser := dtrack.ManagedUser{
+		Username:            acctest.RandomWithPrefix("test-user"),
+		Fullname:            "Test User",
+		Email:               "test.user@example.com",
+		ForcePasswordChange: true,
+		Suspended:           true,
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccManagedUserConfigBasic(testDependencyTrack, testUser, acctest.RandString(20)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					usertestutils.TestAccCheckManagedUserExistsAndHasExpectedData(ctx, testDependencyTrack, testUser),
+					resource.TestCheckResourceAttr(userResourceName, "force_password_change", "true"),
+					resource.TestCheckResourceAttr(userResourceName, "suspended", "true"),
+				),
+			},
+		},
+		CheckDestroy: usertestutils.TestAccCheckManagedUserDoesNotExists(ctx, testDependencyTrack, testUser.Username),
+	})
+}
+
+func TestAccManagedUserResource_emptyPassword(t *testing.T) {
+	testUser := dtrack.ManagedUser{
+		Username: acctest.RandomWithPrefix("test-user"),
+		Fullname: "Test User",
+		Email:    "test.user@example.com",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccManagedUserConfigBasic(testDependencyTrack, testUser, ""),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Value Length`),
+			},
+		},
+	})
+}
+
+func testAccManagedUserConfigBasic(testDependencyTrack *testutils.TestDependencyTrack, user dtrack.ManagedUser, password string) string {
+	return testDependencyTrack.AddProviderConfiguration(
+		fmt.Sprintf(`
+resource "dependencytrack_managed_user" "test" {
+	username              = %[1]q
+	fullname              = %[2]q
+	email                 = %[3]q
+	password              = %[4]q
+	force_password_change = %[5]t
+	non_expiry_password   = %[6]t
+	suspended             = %[7]t
+}
+`,
+			user.Username, user.Fullname, user.Email, password, user.ForcePasswordChange, user.NonExpiryPassword, user.Suspended,
+		),
+	)
+}
@@ -0,0 +1,159 @@
+// Copyright (c) 2024 Futurice Oy
+// SPDX-License-Identifier: MPL-2.0
+
+package user
+
+import (
+	"context"
+	"fmt"
+
+	dtrack "github.com/futurice/dependency-track-client-go"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/providerdata"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &OIDCUserResource{}
+var _ resource.ResourceWithImportState = &OIDCUserResource{}
+
+func NewOIDCUserResource() resource.Resource {
+	return &OIDCUserResource{}
+}
+
+// OIDCUserResource defines the resource implementation.
+type OIDCUserResource struct {
+	providerdata.ResourceBase
+}
+
+// OIDCUserResourceModel describes the resource data model.
+type OIDCUserResourceModel struct {
+	ID                types.String `tfsdk:"id"`
+	Username          types.String `tfsdk:"username"`
+	SubjectIdentifier types.String `tfsdk:"subject_identifier"`
+	Email             types.String `tfsdk:"email"`
+}
+
+func (r *OIDCUserResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_oidc_user"
+}
+
+func (r *OIDCUserResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "OIDC user. The other details of the user are filled in by Dependency-Track when the user logs in.",
+
+		Attributes: map[string]schema.Attribute{
+			"username": schema.StringAttribute{
+				MarkdownDescription: "Username of the user, as given by the username claim of the identity provider",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"subject_identifier": schema.StringAttribute{
+				MarkdownDescription: "Subject identifier of the user, set by Dependency-Track on the first login of the user",
+				Computed:            true,
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "Email address of the user",
+				Computed:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Username of the user, same as username",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *OIDCUserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan OIDCUserResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	respUser, err := r.Client.User.CreateOIDC(ctx, dtrack.OIDCUser{Username: plan.Username.ValueString()})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create OIDC user, got error: %s", err))
+		return
+	}
+
+	state := DTOIDCUserToTFOIDCUser(respUser)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *OIDCUserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state OIDCUserResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	respUser, err := findUser(func(po dtrack.PageOptions) (dtrack.Page[dtrack.OIDCUser], error) {
+		return r.Client.User.GetAllOIDC(ctx, po)
+	}, func(user dtrack.OIDCUser) string { return user.Username }, state.Username.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read OIDC user, got error: %s", err))
+		return
+	}
+	if respUser == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state = DTOIDCUserToTFOIDCUser(*respUser)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *OIDCUserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.AddError("Internal Error", "OIDC user resource is immutable")
+}
+
+func (r *OIDCUserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state OIDCUserResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.Client.User.DeleteOIDC(ctx, dtrack.OIDCUser{Username: state.Username.ValueString()})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete OIDC user, got error: %s", err))
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// ImportState imports an OIDC user by its username.
+func (r *OIDCUserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("username"), req.ID)...)
+}
+
+func DTOIDCUserToTFOIDCUser(dtUser dtrack.OIDCUser) OIDCUserResourceModel {
+	return OIDCUserResourceModel{
+		ID:                types.StringValue(dtUser.Username),
+		Username:          types.StringValue(dtUser.Username),
+		SubjectIdentifier: types.StringValue(dtUser.SubjectIdentifier),
+		Email:             types.StringValue(dtUser.Email),
+	}
+}
@@ -0,0 +1,64 @@
+// Copyright (c) 2024 Futurice Oy
+// SPDX-License-Identifier: MPL-2.0
+
+package user_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/futurice/terraform-provider-dependencytrack/internal/testutils"
+	usertestutils "github.com/futurice/terraform-provider-dependencytrack/internal/testutils/user"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccOIDCUserResource_basic(t *testing.T) {
+	ctx := testutils.CreateTestContext(t)
+
+	userResourceName := usertestutils.CreateOIDCUserResourceName("test")
+
+	username := acctest.RandomWithPrefix("test-user")
+	otherUsername := acctest.RandomWithPrefix("other-test-user")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOIDCUserConfigBasic(testDependencyTrack, username),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					usertestutils.TestAccCheckOIDCUserExists(ctx, testDependencyTrack, username),
+					resource.TestCheckResourceAttr(userResourceName, "id", username),
+					resource.TestCheckResourceAttr(userResourceName, "username", username),
+				),
+			},
+			{
+				ResourceName:      userResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccOIDCUserConfigBasic(testDependencyTrack, otherUsername),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					usertestutils.TestAccCheckOIDCUserExists(ctx, testDependencyTrack, otherUsername),
+					usertestutils.TestAccCheckOIDCUserDoesNotExists(ctx, testDependencyTrack, username),
+					resource.TestCheckResourceAttr(userResourceName, "username", otherUsername),
+				),
+			},
+		},
+		CheckDestroy: usertestutils.TestAccCheckOIDCUserDoesNotExists(ctx, testDependencyTrack, otherUsername),
+	})
+}
+
+func testAccOIDCUserConfigBasic(testDependencyTrack *testutils.TestDependencyTrack, username string) string {
+	return testDependencyTrack.AddProviderConfiguration(
+		fmt.Sprintf(`
+resource "dependencytrack_oidc_user" "test" {
+	username = %[1]q
+}
+`,
+			username,
+		),
+	)
+}
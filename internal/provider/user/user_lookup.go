@@ -0,0 +1,25 @@
+// Copyright (c) 2024 Futurice Oy
+// SPDX-License-Identifier: MPL-2.0
+
+package user
+
+import (
+	dtrack "github.com/futurice/dependency-track-client-go"
+)
+
+// findUser looks up a user from the users returned by fetchPage. Dependency-Track has no endpoint for getting a single
+// user, so all users of the type are gone through. Nil is returned if there is no such user.
+func findUser[T any](fetchPage func(po dtrack.PageOptions) (dtrack.Page[T], error), username func(user T) string, wantedUsername string) (*T, error) {
+	users, err := dtrack.FetchAll(fetchPage)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, user := range users {
+		if username(user) == wantedUsername {
+			return &user, nil
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,98 @@
+// Copyright (c) 2024 Futurice Oy
+// SPDX-License-Identifier: MPL-2.0
+
+package user
+
+import (
+	"context"
+	"fmt"
+
+	dtrack "github.com/futurice/dependency-track-client-go"
+)
+
+// Types of users, used to tell which kind of user e.g. a member of a team is.
+const (
+	TypeManaged = "MANAGED"
+	TypeLDAP    = "LDAP"
+	TypeOIDC    = "OIDC"
+)
+
+var Types = []string{TypeManaged, TypeLDAP, TypeOIDC}
+
+// FetchTeamsOfUsers returns the teams of all users of the type by their usernames. Neither the users nor the teams
+// can be fetched individually with their memberships, so this is the only way to find out the members of a team.
+func FetchTeamsOfUsers(ctx context.Context, client *dtrack.Client, userType string) (map[string][]dtrack.Team, error) {
+	principals, err := fetchPrincipals(ctx, client, userType)
+	if err != nil {
+		return nil, err
+	}
+
+	teams := make(map[string][]dtrack.Team, len(principals))
+	for _, principal := range principals {
+		teams[principal.Username] = principal.Teams
+	}
+
+	return teams, nil
+}
+
+// FindUser looks up a user of any type by the username. The managed users are searched first, then the LDAP users
+// and the OIDC users, like Dependency-Track does when e.g. granting permissions to a user. Nil is returned if there
+// is no such user.
+func FindUser(ctx context.Context, client *dtrack.Client, username string) (*dtrack.UserPrincipal, error) {
+	for _, userType := range Types {
+		principals, err := fetchPrincipals(ctx, client, userType)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, principal := range principals {
+			if principal.Username == username {
+				return &principal, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// fetchPrincipals returns all users of the type, with the details common to all types of users.
+func fetchPrincipals(ctx context.Context, client *dtrack.Client, userType string) ([]dtrack.UserPrincipal, error) {
+	var principals []dtrack.UserPrincipal
+
+	switch userType {
+	case TypeManaged:
+		users, err := dtrack.FetchAll(func(po dtrack.PageOptions) (dtrack.Page[dtrack.ManagedUser], error) {
+			return client.User.GetAllManaged(ctx, po)
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, user := range users {
+			principals = append(principals, dtrack.UserPrincipal{Username: user.Username, Email: user.Email, Teams: user.Teams, Permissions: user.Permissions})
+		}
+	case TypeLDAP:
+		users, err := dtrack.FetchAll(func(po dtrack.PageOptions) (dtrack.Page[dtrack.LDAPUser], error) {
+			return client.User.GetAllLDAP(ctx, po)
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, user := range users {
+			principals = append(principals, dtrack.UserPrincipal{Username: user.Username, Email: user.Email, Teams: user.Teams, Permissions: user.Permissions})
+		}
+	case TypeOIDC:
+		users, err := dtrack.FetchAll(func(po dtrack.PageOptions) (dtrack.Page[dtrack.OIDCUser], error) {
+			return client.User.GetAllOIDC(ctx, po)
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, user := range users {
+			principals = append(principals, dtrack.UserPrincipal{Username: user.Username, Email: user.Email, Teams: user.Teams, Permissions: user.Permissions})
+		}
+	default:
+		return nil, fmt.Errorf("unknown user type %s", userType)
+	}
+
+	return principals, nil
+}
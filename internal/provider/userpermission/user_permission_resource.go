@@ -0,0 +1,227 @@
+// Copyright (c) 2024 Futurice Oy
+// SPDX-License-Identifier: MPL-2.0
+
+package userpermission
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	dtrack "github.com/futurice/dependency-track-client-go"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/provider/user"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/providerdata"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &UserPermissionResource{}
+var _ resource.ResourceWithImportState = &UserPermissionResource{}
+var _ resource.ResourceWithModifyPlan = &UserPermissionResource{}
+
+// permissionsCacheKey is the cache key of the names of all permissions of the server.
+const permissionsCacheKey = "permissions"
+
+func NewUserPermissionResource() resource.Resource {
+	return &UserPermissionResource{}
+}
+
+// UserPermissionResource defines the resource implementation.
+type UserPermissionResource struct {
+	providerdata.ResourceBase
+}
+
+// UserPermissionResourceModel describes the resource data model.
+type UserPermissionResourceModel struct {
+	ID       types.String `tfsdk:"id"`
+	Username types.String `tfsdk:"username"`
+	Name     types.String `tfsdk:"name"`
+}
+
+func (r *UserPermissionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_permission"
+}
+
+func (r *UserPermissionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Permission granted directly to a user, instead of via a team",
+
+		Attributes: map[string]schema.Attribute{
+			"username": schema.StringAttribute{
+				MarkdownDescription: "Username of the user. The user can be a managed, LDAP or OIDC user.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the permission, e.g. `BOM_UPLOAD`",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Synthetic permission ID in the form of username/permission_name",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// ModifyPlan checks that the permission exists on the server, so that a typo is reported already when planning.
+func (r *UserPermissionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.ProviderData == nil {
+		return
+	}
+
+	var name types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("name"), &name)...)
+	if resp.Diagnostics.HasError() || name.IsUnknown() {
+		return
+	}
+
+	permissions, err := providerdata.CacheLoad(r.Cache, permissionsCacheKey, func() ([]string, error) {
+		return r.fetchPermissionNames(ctx)
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get permissions, got error: %s", err))
+		return
+	}
+
+	if !slices.Contains(permissions, name.ValueString()) {
+		resp.Diagnostics.AddAttributeError(path.Root("name"), "Unknown Permission",
+			fmt.Sprintf("The permission %s does not exist, expected one of [%s].", name.ValueString(), strings.Join(permissions, ", ")))
+	}
+}
+
+func (r *UserPermissionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan UserPermissionResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	username := plan.Username.ValueString()
+	permission := dtrack.Permission{
+		Name: plan.Name.ValueString(),
+	}
+
+	_, err := r.Client.Permission.AddPermissionToUser(ctx, permission, username)
+	if err != nil {
+		var apiErr *dtrack.APIError
+		if errors.As(err, &apiErr) {
+			switch apiErr.StatusCode {
+			case http.StatusNotModified:
+				resp.Diagnostics.AddError("Client Error", "The permission already existed on the user")
+				return
+			case http.StatusNotFound:
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("The user '%s' or the permission '%s' not found", username, permission.Name))
+				return
+			}
+		}
+
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create permission, got error: %s", err))
+		return
+	}
+
+	plan.ID = types.StringValue(makePermissionID(username, permission.Name))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *UserPermissionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state UserPermissionResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	respUser, err := user.FindUser(ctx, r.Client, state.Username.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read user, got error: %s", err))
+		return
+	}
+
+	if respUser == nil || !slices.ContainsFunc(respUser.Permissions, func(p dtrack.Permission) bool { return p.Name == state.Name.ValueString() }) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.ID = types.StringValue(makePermissionID(state.Username.ValueString(), state.Name.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *UserPermissionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.AddError("Internal Error", "User permission resource is immutable")
+}
+
+func (r *UserPermissionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state UserPermissionResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	permission := dtrack.Permission{
+		Name: state.Name.ValueString(),
+	}
+
+	_, err := r.Client.Permission.RemovePermissionFromUser(ctx, permission, state.Username.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete permission, got error: %s", err))
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *UserPermissionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Permission names do not contain slashes, but usernames might
+	i := strings.LastIndex(req.ID, "/")
+	if i <= 0 || i == len(req.ID)-1 {
+		resp.Diagnostics.AddError("Invalid import ID", fmt.Sprintf("Expected ID in the format 'username/permission_name', got [%s]", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("username"), req.ID[:i])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), req.ID[i+1:])...)
+}
+
+func (r *UserPermissionResource) fetchPermissionNames(ctx context.Context) ([]string, error) {
+	permissions, err := dtrack.FetchAll(func(po dtrack.PageOptions) (dtrack.Page[dtrack.Permission], error) {
+		return r.Client.Permission.GetAll(ctx, po)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, len(permissions))
+	for i, permission := range permissions {
+		names[i] = permission.Name
+	}
+
+	return names, nil
+}
+
+func makePermissionID(username, permission string) string {
+	return fmt.Sprintf("%s/%s", username, permission)
+}
@@ -0,0 +1,152 @@
+// Copyright (c) 2024 Futurice Oy
+// SPDX-License-Identifier: MPL-2.0
+
+package userpermission_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	dtrack "github.com/futurice/dependency-track-client-go"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/testutils"
+	usertestutils "github.com/futurice/terraform-provider-dependencytrack/internal/testutils/user"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+var testDependencyTrack *testutils.TestDependencyTrack
+
+func TestMain(m *testing.M) {
+	if os.Getenv(resource.EnvTfAcc) != "" {
+		var cleanup func()
+		testDependencyTrack, cleanup = testutils.InitTestDependencyTrack()
+		defer cleanup()
+	}
+
+	m.Run()
+}
+
+func TestAccUserPermissionResource_basic(t *testing.T) {
+	ctx := testutils.CreateTestContext(t)
+
+	username := acctest.RandomWithPrefix("test-user")
+	permissionName := "BOM_UPLOAD"
+	otherPermissionName := "VIEW_PORTFOLIO"
+
+	permissionResourceName := usertestutils.CreateUserPermissionResourceName("test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserPermissionConfigBasic(testDependencyTrack, username, permissionName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					usertestutils.TestAccCheckUserHasExpectedPermissions(ctx, testDependencyTrack, username, []string{permissionName}),
+					resource.TestCheckResourceAttr(permissionResourceName, "id", username+"/"+permissionName),
+					resource.TestCheckResourceAttr(permissionResourceName, "username", username),
+					resource.TestCheckResourceAttr(permissionResourceName, "name", permissionName),
+				),
+			},
+			{
+				ResourceName:      permissionResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccUserPermissionConfigBasic(testDependencyTrack, username, otherPermissionName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					usertestutils.TestAccCheckUserHasExpectedPermissions(ctx, testDependencyTrack, username, []string{otherPermissionName}),
+					resource.TestCheckResourceAttr(permissionResourceName, "id", username+"/"+otherPermissionName),
+					resource.TestCheckResourceAttr(permissionResourceName, "name", otherPermissionName),
+				),
+			},
+			{
+				Config: testAccUserPermissionConfigNoPermission(testDependencyTrack, username),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					usertestutils.TestAccCheckUserHasExpectedPermissions(ctx, testDependencyTrack, username, []string{}),
+				),
+			},
+		},
+		// CheckDestroy is not practical here since the user is destroyed as well, and we can no longer query its permissions
+	})
+}
+
+func TestAccUserPermissionResource_disappears(t *testing.T) {
+	ctx := testutils.CreateTestContext(t)
+
+	username := acctest.RandomWithPrefix("test-user")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserPermissionConfigBasic(testDependencyTrack, username, "BOM_UPLOAD"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccUserPermissionDeleteOutsideTerraform(ctx, username, "BOM_UPLOAD"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccUserPermissionResource_unknownPermission(t *testing.T) {
+	username := acctest.RandomWithPrefix("test-user")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccUserPermissionConfigBasic(testDependencyTrack, username, "BOM_DOWNLOAD"),
+				ExpectError: regexp.MustCompile(`The permission BOM_DOWNLOAD does not exist`),
+			},
+		},
+	})
+}
+
+func testAccUserPermissionDeleteOutsideTerraform(ctx context.Context, username, permissionName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		_, err := testDependencyTrack.Client.Permission.RemovePermissionFromUser(ctx, dtrack.Permission{Name: permissionName}, username)
+		if err != nil {
+			return fmt.Errorf("failed to remove permission %s from user %s: %w", permissionName, username, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccUserPermissionConfigNoPermission(testDependencyTrack *testutils.TestDependencyTrack, username string) string {
+	return testDependencyTrack.AddProviderConfiguration(
+		fmt.Sprintf(`
+resource "dependencytrack_managed_user" "test" {
+	username = %[1]q
+	fullname = "Test User"
+	email    = "test.user@example.com"
+	password = "test-password"
+}
+`,
+			username,
+		),
+	)
+}
+
+func testAccUserPermissionConfigBasic(testDependencyTrack *testutils.TestDependencyTrack, username, permissionName string) string {
+	return testutils.ComposeConfigs(
+		testAccUserPermissionConfigNoPermission(testDependencyTrack, username),
+		fmt.Sprintf(`
+resource "dependencytrack_user_permission" "test" {
+	username = dependencytrack_managed_user.test.username
+	name     = %[1]q
+}
+`,
+			permissionName,
+		),
+	)
+}
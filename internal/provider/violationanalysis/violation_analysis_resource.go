@@ -0,0 +1,308 @@
+// Copyright (c) 2024 Futurice Oy
+// SPDX-License-Identifier: MPL-2.0
+
+package violationanalysis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	dtrack "github.com/futurice/dependency-track-client-go"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/providerdata"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/utils"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ViolationAnalysisResource{}
+var _ resource.ResourceWithImportState = &ViolationAnalysisResource{}
+
+// notSet is the state of a violation that has not been analysed.
+const notSet = "NOT_SET"
+
+var states = []string{"APPROVED", "REJECTED", notSet}
+
+func NewViolationAnalysisResource() resource.Resource {
+	return &ViolationAnalysisResource{}
+}
+
+// ViolationAnalysisResource defines the resource implementation.
+type ViolationAnalysisResource struct {
+	providerdata.ResourceBase
+}
+
+// ViolationAnalysisResourceModel describes the resource data model.
+type ViolationAnalysisResourceModel struct {
+	ID                types.String `tfsdk:"id"`
+	ComponentID       types.String `tfsdk:"component_id"`
+	PolicyViolationID types.String `tfsdk:"policy_violation_id"`
+	State             types.String `tfsdk:"state"`
+	Suppressed        types.Bool   `tfsdk:"suppressed"`
+	Comment           types.String `tfsdk:"comment"`
+	ResetOnDestroy    types.Bool   `tfsdk:"reset_on_destroy"`
+}
+
+func (r *ViolationAnalysisResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_violation_analysis"
+}
+
+func (r *ViolationAnalysisResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Analysis of a policy violation, e.g. the approval of a license exception. The violation can be " +
+			"looked up with the `dependencytrack_policy_violation` data source.",
+
+		Attributes: map[string]schema.Attribute{
+			"component_id": schema.StringAttribute{
+				MarkdownDescription: "UUID of the component violating the policy",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"policy_violation_id": schema.StringAttribute{
+				MarkdownDescription: "Policy violation UUID",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"state": schema.StringAttribute{
+				MarkdownDescription: "Analysis state. Must be one of the following values: [" + strings.Join(states, ", ") + "]",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(states...),
+				},
+			},
+			"suppressed": schema.BoolAttribute{
+				MarkdownDescription: "Whether the violation is suppressed. Default is false.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"comment": schema.StringAttribute{
+				MarkdownDescription: "Comment added to the audit trail of the violation when the analysis is created or " +
+					"changed, e.g. the reason for approving the violation. Comments cannot be removed from the audit trail.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"reset_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: "Whether destroying the resource resets the analysis to " + notSet + " and unsuppresses " +
+					"the violation. If false, the analysis is left as is. Default is true.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Synthetic violation analysis ID in the form of component_id/policy_violation_id",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ViolationAnalysisResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ViolationAnalysisResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	analysisReq, diags := TFViolationAnalysisToDTViolationAnalysisRequest(plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	respAnalysis, err := r.Client.ViolationAnalysis.Update(ctx, analysisReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create violation analysis, got error: %s", err))
+		return
+	}
+
+	plan.ID = types.StringValue(makeViolationAnalysisID(analysisReq.Component, analysisReq.PolicyViolation))
+	updateTFViolationAnalysis(&plan, respAnalysis)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ViolationAnalysisResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ViolationAnalysisResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	componentID, violationID, diags := parseViolationIDs(state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	respAnalysis, err := r.Client.ViolationAnalysis.Get(ctx, componentID, violationID)
+	if err != nil {
+		var apiErr *dtrack.APIError
+		if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read violation analysis, got error: %s", err))
+		return
+	}
+
+	state.ID = types.StringValue(makeViolationAnalysisID(componentID, violationID))
+	updateTFViolationAnalysis(&state, respAnalysis)
+
+	// Not known when importing
+	if state.ResetOnDestroy.IsNull() {
+		state.ResetOnDestroy = types.BoolValue(true)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ViolationAnalysisResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state ViolationAnalysisResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	analysisReq, diags := TFViolationAnalysisToDTViolationAnalysisRequest(plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only add the comment to the audit trail once
+	if plan.Comment.Equal(state.Comment) {
+		analysisReq.Comment = ""
+	}
+
+	respAnalysis, err := r.Client.ViolationAnalysis.Update(ctx, analysisReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update violation analysis, got error: %s", err))
+		return
+	}
+
+	updateTFViolationAnalysis(&plan, respAnalysis)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ViolationAnalysisResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ViolationAnalysisResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !state.ResetOnDestroy.ValueBool() {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	componentID, violationID, diags := parseViolationIDs(state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	suppressed := false
+	_, err := r.Client.ViolationAnalysis.Update(ctx, dtrack.ViolationAnalysisRequest{
+		Component:       componentID,
+		PolicyViolation: violationID,
+		State:           notSet,
+		Suppressed:      &suppressed,
+	})
+	if err != nil {
+		var apiErr *dtrack.APIError
+		if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
+			// The violation no longer exists, e.g. because the component no longer violates the policy
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to reset violation analysis, got error: %s", err))
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *ViolationAnalysisResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 2 {
+		resp.Diagnostics.AddError("Invalid import ID", fmt.Sprintf("Expected ID in the format 'component_id/policy_violation_id', got [%s]", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("component_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("policy_violation_id"), parts[1])...)
+}
+
+func parseViolationIDs(tfAnalysis ViolationAnalysisResourceModel) (uuid.UUID, uuid.UUID, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	componentID, componentIDDiags := utils.ParseAttributeUUID(tfAnalysis.ComponentID.ValueString(), "component_id")
+	diags.Append(componentIDDiags...)
+
+	violationID, violationIDDiags := utils.ParseAttributeUUID(tfAnalysis.PolicyViolationID.ValueString(), "policy_violation_id")
+	diags.Append(violationIDDiags...)
+
+	return componentID, violationID, diags
+}
+
+func TFViolationAnalysisToDTViolationAnalysisRequest(tfAnalysis ViolationAnalysisResourceModel) (dtrack.ViolationAnalysisRequest, diag.Diagnostics) {
+	componentID, violationID, diags := parseViolationIDs(tfAnalysis)
+
+	suppressed := tfAnalysis.Suppressed.ValueBool()
+
+	return dtrack.ViolationAnalysisRequest{
+		Component:       componentID,
+		PolicyViolation: violationID,
+		Comment:         tfAnalysis.Comment.ValueString(),
+		State:           dtrack.ViolationAnalysisState(tfAnalysis.State.ValueString()),
+		Suppressed:      &suppressed,
+	}, diags
+}
+
+// updateTFViolationAnalysis sets the analysed values of tfAnalysis from dtAnalysis, so that changes made e.g. in the
+// user interface show up as drift. The comment is left as is, as it is only added to the audit trail.
+func updateTFViolationAnalysis(tfAnalysis *ViolationAnalysisResourceModel, dtAnalysis dtrack.ViolationAnalysis) {
+	state := string(dtAnalysis.State)
+	if state == "" {
+		state = notSet
+	}
+
+	tfAnalysis.State = types.StringValue(state)
+	tfAnalysis.Suppressed = types.BoolValue(dtAnalysis.Suppressed)
+}
+
+func makeViolationAnalysisID(componentID, violationID uuid.UUID) string {
+	return fmt.Sprintf("%s/%s", componentID.String(), violationID.String())
+}
@@ -0,0 +1,139 @@
+// Copyright (c) 2024 Futurice Oy
+// SPDX-License-Identifier: MPL-2.0
+
+package violationanalysis_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	dtrack "github.com/futurice/dependency-track-client-go"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/testutils"
+	violationtestutils "github.com/futurice/terraform-provider-dependencytrack/internal/testutils/violation"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+var testDependencyTrack *testutils.TestDependencyTrack
+
+func TestMain(m *testing.M) {
+	if os.Getenv(resource.EnvTfAcc) != "" {
+		var cleanup func()
+		testDependencyTrack, cleanup = testutils.InitTestDependencyTrack()
+		defer cleanup()
+	}
+
+	m.Run()
+}
+
+func TestAccViolationAnalysisResource_basic(t *testing.T) {
+	ctx := testutils.CreateTestContext(t)
+
+	violation := violationtestutils.CreateTestPolicyViolation(ctx, t, testDependencyTrack)
+	analysisResourceName := violationtestutils.CreateViolationAnalysisResourceName("test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccViolationAnalysisConfigBasic(testDependencyTrack, violation, "APPROVED", false, "Approved license exception"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					violationtestutils.TestAccCheckViolationAnalysisHasExpectedData(ctx, testDependencyTrack, violation, "APPROVED", false),
+					violationtestutils.TestAccCheckViolationAnalysisHasComment(ctx, testDependencyTrack, violation, "Approved license exception"),
+					resource.TestCheckResourceAttr(analysisResourceName, "id", fmt.Sprintf("%s/%s", violation.ComponentID, violation.ViolationID)),
+					resource.TestCheckResourceAttr(analysisResourceName, "state", "APPROVED"),
+					resource.TestCheckResourceAttr(analysisResourceName, "suppressed", "false"),
+					resource.TestCheckResourceAttr(analysisResourceName, "reset_on_destroy", "true"),
+				),
+			},
+			{
+				ResourceName:            analysisResourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"comment"},
+			},
+			{
+				Config: testAccViolationAnalysisConfigBasic(testDependencyTrack, violation, "REJECTED", true, "Exception no longer needed"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					violationtestutils.TestAccCheckViolationAnalysisHasExpectedData(ctx, testDependencyTrack, violation, "REJECTED", true),
+					violationtestutils.TestAccCheckViolationAnalysisHasComment(ctx, testDependencyTrack, violation, "Exception no longer needed"),
+					resource.TestCheckResourceAttr(analysisResourceName, "state", "REJECTED"),
+					resource.TestCheckResourceAttr(analysisResourceName, "suppressed", "true"),
+				),
+			},
+			{
+				Config: testAccViolationAnalysisConfigNoAnalysis(testDependencyTrack),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					violationtestutils.TestAccCheckViolationAnalysisHasExpectedData(ctx, testDependencyTrack, violation, "NOT_SET", false),
+				),
+			},
+		},
+	})
+}
+
+func TestAccViolationAnalysisResource_drift(t *testing.T) {
+	ctx := testutils.CreateTestContext(t)
+
+	violation := violationtestutils.CreateTestPolicyViolation(ctx, t, testDependencyTrack)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccViolationAnalysisConfigBasic(testDependencyTrack, violation, "APPROVED", false, "Approved license exception"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccViolationAnalysisChangeOutsideTerraform(ctx, violation),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccViolationAnalysisConfigBasic(testDependencyTrack, violation, "APPROVED", false, "Approved license exception"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					violationtestutils.TestAccCheckViolationAnalysisHasExpectedData(ctx, testDependencyTrack, violation, "APPROVED", false),
+				),
+			},
+		},
+	})
+}
+
+// testAccViolationAnalysisChangeOutsideTerraform changes the analysis like an analyst would in the user interface.
+func testAccViolationAnalysisChangeOutsideTerraform(ctx context.Context, violation violationtestutils.Violation) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		suppressed := true
+		_, err := testDependencyTrack.Client.ViolationAnalysis.Update(ctx, dtrack.ViolationAnalysisRequest{
+			Component:       violation.ComponentID,
+			PolicyViolation: violation.ViolationID,
+			State:           "REJECTED",
+			Suppressed:      &suppressed,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to change analysis of policy violation %s: %w", violation.ViolationID, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccViolationAnalysisConfigBasic(testDependencyTrack *testutils.TestDependencyTrack, violation violationtestutils.Violation, state string, suppressed bool, comment string) string {
+	return testDependencyTrack.AddProviderConfiguration(
+		fmt.Sprintf(`
+resource "dependencytrack_violation_analysis" "test" {
+	component_id        = %[1]q
+	policy_violation_id = %[2]q
+	state               = %[3]q
+	suppressed          = %[4]t
+	comment             = %[5]q
+}
+`,
+			violation.ComponentID, violation.ViolationID, state, suppressed, comment,
+		),
+	)
+}
+
+func testAccViolationAnalysisConfigNoAnalysis(testDependencyTrack *testutils.TestDependencyTrack) string {
+	return testDependencyTrack.AddProviderConfiguration("")
+}
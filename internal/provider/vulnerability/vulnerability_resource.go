@@ -0,0 +1,617 @@
+// Copyright (c) 2024 Futurice Oy
+// SPDX-License-Identifier: MPL-2.0
+
+package vulnerability
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	dtrack "github.com/futurice/dependency-track-client-go"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/providerdata"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/utils"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &VulnerabilityResource{}
+var _ resource.ResourceWithImportState = &VulnerabilityResource{}
+var _ resource.ResourceWithModifyPlan = &VulnerabilityResource{}
+
+// internalSource is the source of the vulnerabilities created in Dependency-Track.
+const internalSource = "INTERNAL"
+
+const severityUnassigned = "UNASSIGNED"
+
+var identityTypes = []string{"PURL", "CPE"}
+
+var versionTypes = []string{"EXACT", "RANGE"}
+
+var affectedComponentAttrTypes = map[string]attr.Type{
+	"identity_type":           types.StringType,
+	"identity":                types.StringType,
+	"version_type":            types.StringType,
+	"version":                 types.StringType,
+	"version_start_including": types.StringType,
+	"version_start_excluding": types.StringType,
+	"version_end_including":   types.StringType,
+	"version_end_excluding":   types.StringType,
+}
+
+func NewVulnerabilityResource() resource.Resource {
+	return &VulnerabilityResource{}
+}
+
+// VulnerabilityResource defines the resource implementation.
+type VulnerabilityResource struct {
+	providerdata.ResourceBase
+}
+
+// VulnerabilityResourceModel describes the resource data model.
+type VulnerabilityResourceModel struct {
+	ID                 types.String  `tfsdk:"id"`
+	VulnID             types.String  `tfsdk:"vuln_id"`
+	Title              types.String  `tfsdk:"title"`
+	SubTitle           types.String  `tfsdk:"sub_title"`
+	Description        types.String  `tfsdk:"description"`
+	Recommendation     types.String  `tfsdk:"recommendation"`
+	References         types.String  `tfsdk:"references"`
+	Credits            types.String  `tfsdk:"credits"`
+	CWEs               types.Set     `tfsdk:"cwes"`
+	CVSSV2Vector       types.String  `tfsdk:"cvss_v2_vector"`
+	CVSSV2BaseScore    types.Float64 `tfsdk:"cvss_v2_base_score"`
+	CVSSV3Vector       types.String  `tfsdk:"cvss_v3_vector"`
+	CVSSV3BaseScore    types.Float64 `tfsdk:"cvss_v3_base_score"`
+	OWASPRRVector      types.String  `tfsdk:"owasp_rr_vector"`
+	Severity           types.String  `tfsdk:"severity"`
+	VulnerableVersions types.String  `tfsdk:"vulnerable_versions"`
+	PatchedVersions    types.String  `tfsdk:"patched_versions"`
+	AffectedComponents types.Set     `tfsdk:"affected_components"`
+}
+
+// AffectedComponentModel describes a component affected by the vulnerability.
+type AffectedComponentModel struct {
+	IdentityType          types.String `tfsdk:"identity_type"`
+	Identity              types.String `tfsdk:"identity"`
+	VersionType           types.String `tfsdk:"version_type"`
+	Version               types.String `tfsdk:"version"`
+	VersionStartIncluding types.String `tfsdk:"version_start_including"`
+	VersionStartExcluding types.String `tfsdk:"version_start_excluding"`
+	VersionEndIncluding   types.String `tfsdk:"version_end_including"`
+	VersionEndExcluding   types.String `tfsdk:"version_end_excluding"`
+}
+
+func (r *VulnerabilityResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vulnerability"
+}
+
+func (r *VulnerabilityResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	vectorPaths := []path.Expression{
+		path.MatchRoot("cvss_v2_vector"),
+		path.MatchRoot("cvss_v3_vector"),
+		path.MatchRoot("owasp_rr_vector"),
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Internal vulnerability, e.g. an advisory for an internal library. The severity is calculated " +
+			"from the CVSS v3 vector, the CVSS v2 vector or the OWASP Risk Rating vector, in this order, if any of them is set.",
+
+		Attributes: map[string]schema.Attribute{
+			"vuln_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the vulnerability, e.g. INT-2024-0001",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"title": schema.StringAttribute{
+				MarkdownDescription: "Title of the vulnerability",
+				Optional:            true,
+			},
+			"sub_title": schema.StringAttribute{
+				MarkdownDescription: "Subtitle of the vulnerability",
+				Optional:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the vulnerability",
+				Optional:            true,
+			},
+			"recommendation": schema.StringAttribute{
+				MarkdownDescription: "Recommendation for remediating the vulnerability",
+				Optional:            true,
+			},
+			"references": schema.StringAttribute{
+				MarkdownDescription: "References of the vulnerability, in Markdown",
+				Optional:            true,
+			},
+			"credits": schema.StringAttribute{
+				MarkdownDescription: "Credits for finding the vulnerability",
+				Optional:            true,
+			},
+			"cwes": schema.SetAttribute{
+				ElementType:         types.Int64Type,
+				MarkdownDescription: "IDs of the CWEs of the vulnerability, e.g. 79 for CWE-79",
+				Optional:            true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueInt64sAre(int64validator.AtLeast(1)),
+				},
+			},
+			"cvss_v2_vector": schema.StringAttribute{
+				MarkdownDescription: "CVSS v2 vector, e.g. `AV:N/AC:L/Au:N/C:P/I:P/A:P`. The vector may be enclosed in parentheses, as Dependency-Track stores it.",
+				Optional:            true,
+				Validators: []validator.String{
+					validators.CVSSv2Vector(),
+				},
+			},
+			"cvss_v2_base_score": schema.Float64Attribute{
+				MarkdownDescription: "CVSS v2 base score calculated from the vector",
+				Computed:            true,
+			},
+			"cvss_v3_vector": schema.StringAttribute{
+				MarkdownDescription: "CVSS v3.0 or v3.1 vector, e.g. `CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H`",
+				Optional:            true,
+				Validators: []validator.String{
+					validators.CVSSv3Vector(),
+				},
+			},
+			"cvss_v3_base_score": schema.Float64Attribute{
+				MarkdownDescription: "CVSS v3 base score calculated from the vector",
+				Computed:            true,
+			},
+			"owasp_rr_vector": schema.StringAttribute{
+				MarkdownDescription: "OWASP Risk Rating vector, e.g. `SL:1/M:1/O:0/S:2/ED:1/EE:1/A:1/ID:1/LC:2/LI:1/LAV:1/LAC:1/FD:1/RD:1/NC:2/PV:3`",
+				Optional:            true,
+				Validators: []validator.String{
+					validators.OWASPRiskRatingVector(),
+				},
+			},
+			"severity": schema.StringAttribute{
+				MarkdownDescription: "Severity of the vulnerability. Calculated from the vectors if any of them is set, " +
+					"otherwise it can be set explicitly. Must be one of the following values: [" + strings.Join(severities, ", ") + "]. " +
+					"Default is " + severityUnassigned + ".",
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(severities...),
+					stringvalidator.ConflictsWith(vectorPaths...),
+				},
+			},
+			"vulnerable_versions": schema.StringAttribute{
+				MarkdownDescription: "Description of the vulnerable versions",
+				Optional:            true,
+			},
+			"patched_versions": schema.StringAttribute{
+				MarkdownDescription: "Description of the patched versions",
+				Optional:            true,
+			},
+			"affected_components": schema.SetNestedAttribute{
+				MarkdownDescription: "Components affected by the vulnerability. Projects using the components get a finding " +
+					"of the vulnerability on their next analysis.",
+				Optional: true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"identity_type": schema.StringAttribute{
+							MarkdownDescription: "Type of the identity. Must be one of the following values: [" + strings.Join(identityTypes, ", ") + "]",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(identityTypes...),
+							},
+						},
+						"identity": schema.StringAttribute{
+							MarkdownDescription: "PURL or CPE of the component, without a version for version ranges",
+							Required:            true,
+						},
+						"version_type": schema.StringAttribute{
+							MarkdownDescription: "Type of the version. Must be one of the following values: [" + strings.Join(versionTypes, ", ") + "]",
+							Optional:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(versionTypes...),
+							},
+						},
+						"version": schema.StringAttribute{
+							MarkdownDescription: "Affected version, for the version type EXACT",
+							Optional:            true,
+						},
+						"version_start_including": schema.StringAttribute{
+							MarkdownDescription: "First affected version, for the version type RANGE",
+							Optional:            true,
+						},
+						"version_start_excluding": schema.StringAttribute{
+							MarkdownDescription: "Version after which versions are affected, for the version type RANGE",
+							Optional:            true,
+						},
+						"version_end_including": schema.StringAttribute{
+							MarkdownDescription: "Last affected version, for the version type RANGE",
+							Optional:            true,
+						},
+						"version_end_excluding": schema.StringAttribute{
+							MarkdownDescription: "First version no longer affected, for the version type RANGE",
+							Optional:            true,
+						},
+					},
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Vulnerability UUID",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// ModifyPlan calculates the scores and the severity from the vectors, so that they are known when planning.
+func (r *VulnerabilityResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, config VulnerabilityResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.CVSSV2Vector.IsUnknown() || plan.CVSSV3Vector.IsUnknown() || plan.OWASPRRVector.IsUnknown() {
+		plan.CVSSV2BaseScore = types.Float64Unknown()
+		plan.CVSSV3BaseScore = types.Float64Unknown()
+		if config.Severity.IsNull() {
+			plan.Severity = types.StringUnknown()
+		}
+	} else {
+		scores, err := calculateScores(plan.CVSSV2Vector.ValueString(), plan.CVSSV3Vector.ValueString(), plan.OWASPRRVector.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid Vector", fmt.Sprintf("Unable to calculate the severity, got error: %s", err))
+			return
+		}
+
+		plan.CVSSV2BaseScore = scores.cvssV2BaseScore
+		plan.CVSSV3BaseScore = scores.cvssV3BaseScore
+
+		switch {
+		case scores.severity != "":
+			plan.Severity = types.StringValue(scores.severity)
+		case config.Severity.IsNull():
+			plan.Severity = types.StringValue(severityUnassigned)
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+func (r *VulnerabilityResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan VulnerabilityResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dtVulnerability, diags := TFVulnerabilityToDTVulnerability(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	respVulnerability, err := r.Client.Vulnerability.Create(ctx, dtVulnerability)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create vulnerability, got error: %s", err))
+		return
+	}
+
+	state, diags := DTVulnerabilityToTFVulnerability(ctx, respVulnerability)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.CVSSV2Vector = keepCVSSv2VectorForm(plan.CVSSV2Vector, state.CVSSV2Vector)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *VulnerabilityResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state VulnerabilityResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vulnerabilityID, vulnerabilityIDDiags := utils.ParseAttributeUUID(state.ID.ValueString(), "id")
+	resp.Diagnostics.Append(vulnerabilityIDDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	respVulnerability, err := r.Client.Vulnerability.Get(ctx, vulnerabilityID)
+	if err != nil {
+		var apiErr *dtrack.APIError
+		if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read vulnerability, got error: %s", err))
+		return
+	}
+
+	if respVulnerability.Source != internalSource {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("The vulnerability %s is not an internal vulnerability, but from the source %s",
+			respVulnerability.VulnID, respVulnerability.Source))
+		return
+	}
+
+	priorCVSSV2Vector := state.CVSSV2Vector
+	state, diags := DTVulnerabilityToTFVulnerability(ctx, respVulnerability)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.CVSSV2Vector = keepCVSSv2VectorForm(priorCVSSV2Vector, state.CVSSV2Vector)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *VulnerabilityResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan VulnerabilityResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dtVulnerability, diags := TFVulnerabilityToDTVulnerability(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vulnerabilityID, vulnerabilityIDDiags := utils.ParseAttributeUUID(plan.ID.ValueString(), "id")
+	resp.Diagnostics.Append(vulnerabilityIDDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	dtVulnerability.UUID = vulnerabilityID
+
+	respVulnerability, err := r.Client.Vulnerability.Update(ctx, dtVulnerability)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update vulnerability, got error: %s", err))
+		return
+	}
+
+	state, diags := DTVulnerabilityToTFVulnerability(ctx, respVulnerability)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.CVSSV2Vector = keepCVSSv2VectorForm(plan.CVSSV2Vector, state.CVSSV2Vector)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *VulnerabilityResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state VulnerabilityResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vulnerabilityID, vulnerabilityIDDiags := utils.ParseAttributeUUID(state.ID.ValueString(), "id")
+	resp.Diagnostics.Append(vulnerabilityIDDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.Client.Vulnerability.Delete(ctx, vulnerabilityID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete vulnerability, got error: %s", err))
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *VulnerabilityResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// keepCVSSv2VectorForm returns the configured CVSS v2 vector if it is the same as the vector returned by
+// Dependency-Track, apart from the enclosing parentheses. Dependency-Track stores CVSS v2 vectors in parentheses, e.g.
+// (AV:N/AC:L/Au:N/C:P/I:P/A:P), while both forms are accepted in the configuration.
+func keepCVSSv2VectorForm(configured, returned types.String) types.String {
+	if configured.IsNull() || configured.IsUnknown() || returned.IsNull() {
+		return returned
+	}
+
+	if strings.Trim(configured.ValueString(), "()") != strings.Trim(returned.ValueString(), "()") {
+		return returned
+	}
+
+	return configured
+}
+
+type vulnerabilityScores struct {
+	cvssV2BaseScore types.Float64
+	cvssV3BaseScore types.Float64
+	// severity is empty if there are no vectors
+	severity string
+}
+
+// calculateScores calculates the base scores of the vectors, and the severity from the first vector set in the
+// order CVSS v3, CVSS v2 and OWASP Risk Rating. Empty vectors are not set.
+func calculateScores(cvssV2Vector, cvssV3Vector, owaspRRVector string) (vulnerabilityScores, error) {
+	scores := vulnerabilityScores{
+		cvssV2BaseScore: types.Float64Null(),
+		cvssV3BaseScore: types.Float64Null(),
+	}
+
+	if owaspRRVector != "" {
+		likelihood, technicalImpact, businessImpact, err := OWASPRiskRatingScores(owaspRRVector)
+		if err != nil {
+			return scores, err
+		}
+		scores.severity = OWASPRiskRatingSeverity(likelihood, technicalImpact, businessImpact)
+	}
+
+	if cvssV2Vector != "" {
+		score, err := CVSSv2BaseScore(cvssV2Vector)
+		if err != nil {
+			return scores, err
+		}
+		scores.cvssV2BaseScore = types.Float64Value(score)
+		scores.severity = CVSSv2Severity(score)
+	}
+
+	if cvssV3Vector != "" {
+		score, err := CVSSv3BaseScore(cvssV3Vector)
+		if err != nil {
+			return scores, err
+		}
+		scores.cvssV3BaseScore = types.Float64Value(score)
+		scores.severity = CVSSv3Severity(score)
+	}
+
+	return scores, nil
+}
+
+func TFVulnerabilityToDTVulnerability(ctx context.Context, tfVulnerability VulnerabilityResourceModel) (dtrack.Vulnerability, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	dtVulnerability := dtrack.Vulnerability{
+		VulnID:             tfVulnerability.VulnID.ValueString(),
+		Source:             internalSource,
+		Title:              tfVulnerability.Title.ValueString(),
+		SubTitle:           tfVulnerability.SubTitle.ValueString(),
+		Description:        tfVulnerability.Description.ValueString(),
+		Recommendation:     tfVulnerability.Recommendation.ValueString(),
+		References:         tfVulnerability.References.ValueString(),
+		Credits:            tfVulnerability.Credits.ValueString(),
+		CVSSV2Vector:       tfVulnerability.CVSSV2Vector.ValueString(),
+		CVSSV2BaseScore:    tfVulnerability.CVSSV2BaseScore.ValueFloat64(),
+		CVSSV3Vector:       tfVulnerability.CVSSV3Vector.ValueString(),
+		CVSSV3BaseScore:    tfVulnerability.CVSSV3BaseScore.ValueFloat64(),
+		OWASPRRVector:      tfVulnerability.OWASPRRVector.ValueString(),
+		Severity:           tfVulnerability.Severity.ValueString(),
+		VulnerableVersions: tfVulnerability.VulnerableVersions.ValueString(),
+		PatchedVersions:    tfVulnerability.PatchedVersions.ValueString(),
+	}
+
+	var cweIDs []int64
+	diags.Append(tfVulnerability.CWEs.ElementsAs(ctx, &cweIDs, false)...)
+	for _, cweID := range cweIDs {
+		dtVulnerability.CWEs = append(dtVulnerability.CWEs, dtrack.CWE{ID: int(cweID)})
+	}
+
+	var affectedComponents []AffectedComponentModel
+	diags.Append(tfVulnerability.AffectedComponents.ElementsAs(ctx, &affectedComponents, false)...)
+	for _, component := range affectedComponents {
+		dtVulnerability.AffectedComponents = append(dtVulnerability.AffectedComponents, dtrack.AffectedComponent{
+			IdentityType:          component.IdentityType.ValueString(),
+			Identity:              component.Identity.ValueString(),
+			VersionType:           component.VersionType.ValueString(),
+			Version:               component.Version.ValueString(),
+			VersionStartIncluding: component.VersionStartIncluding.ValueString(),
+			VersionStartExcluding: component.VersionStartExcluding.ValueString(),
+			VersionEndIncluding:   component.VersionEndIncluding.ValueString(),
+			VersionEndExcluding:   component.VersionEndExcluding.ValueString(),
+		})
+	}
+
+	return dtVulnerability, diags
+}
+
+func DTVulnerabilityToTFVulnerability(ctx context.Context, dtVulnerability dtrack.Vulnerability) (VulnerabilityResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	tfVulnerability := VulnerabilityResourceModel{
+		ID:                 types.StringValue(dtVulnerability.UUID.String()),
+		VulnID:             types.StringValue(dtVulnerability.VulnID),
+		Title:              utils.StringValueOrNull(dtVulnerability.Title),
+		SubTitle:           utils.StringValueOrNull(dtVulnerability.SubTitle),
+		Description:        utils.StringValueOrNull(dtVulnerability.Description),
+		Recommendation:     utils.StringValueOrNull(dtVulnerability.Recommendation),
+		References:         utils.StringValueOrNull(dtVulnerability.References),
+		Credits:            utils.StringValueOrNull(dtVulnerability.Credits),
+		CWEs:               types.SetNull(types.Int64Type),
+		CVSSV2Vector:       utils.StringValueOrNull(strings.Trim(dtVulnerability.CVSSV2Vector, "()")),
+		CVSSV2BaseScore:    types.Float64Null(),
+		CVSSV3Vector:       utils.StringValueOrNull(dtVulnerability.CVSSV3Vector),
+		CVSSV3BaseScore:    types.Float64Null(),
+		OWASPRRVector:      utils.StringValueOrNull(dtVulnerability.OWASPRRVector),
+		Severity:           types.StringValue(dtVulnerability.Severity),
+		VulnerableVersions: utils.StringValueOrNull(dtVulnerability.VulnerableVersions),
+		PatchedVersions:    utils.StringValueOrNull(dtVulnerability.PatchedVersions),
+		AffectedComponents: types.SetNull(types.ObjectType{AttrTypes: affectedComponentAttrTypes}),
+	}
+
+	if dtVulnerability.CVSSV2Vector != "" {
+		tfVulnerability.CVSSV2BaseScore = types.Float64Value(dtVulnerability.CVSSV2BaseScore)
+	}
+	if dtVulnerability.CVSSV3Vector != "" {
+		tfVulnerability.CVSSV3BaseScore = types.Float64Value(dtVulnerability.CVSSV3BaseScore)
+	}
+	if dtVulnerability.Severity == "" {
+		tfVulnerability.Severity = types.StringValue(severityUnassigned)
+	}
+
+	if len(dtVulnerability.CWEs) > 0 {
+		cweIDs := make([]int64, len(dtVulnerability.CWEs))
+		for i, cwe := range dtVulnerability.CWEs {
+			cweIDs[i] = int64(cwe.ID)
+		}
+		sort.Slice(cweIDs, func(i, j int) bool { return cweIDs[i] < cweIDs[j] })
+
+		cwes, cwesDiags := types.SetValueFrom(ctx, types.Int64Type, cweIDs)
+		diags.Append(cwesDiags...)
+		tfVulnerability.CWEs = cwes
+	}
+
+	if len(dtVulnerability.AffectedComponents) > 0 {
+		affectedComponents := make([]AffectedComponentModel, len(dtVulnerability.AffectedComponents))
+		for i, component := range dtVulnerability.AffectedComponents {
+			affectedComponents[i] = AffectedComponentModel{
+				IdentityType:          types.StringValue(component.IdentityType),
+				Identity:              types.StringValue(component.Identity),
+				VersionType:           utils.StringValueOrNull(component.VersionType),
+				Version:               utils.StringValueOrNull(component.Version),
+				VersionStartIncluding: utils.StringValueOrNull(component.VersionStartIncluding),
+				VersionStartExcluding: utils.StringValueOrNull(component.VersionStartExcluding),
+				VersionEndIncluding:   utils.StringValueOrNull(component.VersionEndIncluding),
+				VersionEndExcluding:   utils.StringValueOrNull(component.VersionEndExcluding),
+			}
+		}
+
+		components, componentsDiags := types.SetValueFrom(ctx, types.ObjectType{AttrTypes: affectedComponentAttrTypes}, affectedComponents)
+		diags.Append(componentsDiags...)
+		tfVulnerability.AffectedComponents = components
+	}
+
+	return tfVulnerability, diags
+}
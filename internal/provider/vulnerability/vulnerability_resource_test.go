@@ -0,0 +1,276 @@
+// Copyright (c) 2024 Futurice Oy
+// SPDX-License-Identifier: MPL-2.0
+
+package vulnerability_test
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/futurice/terraform-provider-dependencytrack/internal/testutils"
+	vulnerabilitytestutils "github.com/futurice/terraform-provider-dependencytrack/internal/testutils/vulnerability"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+var testDependencyTrack *testutils.TestDependencyTrack
+
+func TestMain(m *testing.M) {
+	if os.Getenv(resource.EnvTfAcc) != "" {
+		var cleanup func()
+		testDependencyTrack, cleanup = testutils.InitTestDependencyTrack()
+		defer cleanup()
+	}
+
+	m.Run()
+}
+
+func TestAccVulnerabilityResource_basic(t *testing.T) {
+	ctx := testutils.CreateTestContext(t)
+
+	vulnID := acctest.RandomWithPrefix("INT")
+	vulnerabilityResourceName := vulnerabilitytestutils.CreateVulnerabilityResourceName("test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVulnerabilityConfigBasic(testDependencyTrack, vulnID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					vulnerabilitytestutils.TestAccCheckVulnerabilityExistsAndHasExpectedSeverity(ctx, testDependencyTrack, vulnerabilityResourceName, "CRITICAL"),
+					resource.TestCheckResourceAttr(vulnerabilityResourceName, "vuln_id", vulnID),
+					resource.TestCheckResourceAttr(vulnerabilityResourceName, "title", "Remote code execution"),
+					resource.TestCheckResourceAttr(vulnerabilityResourceName, "cwes.#", "1"),
+					resource.TestCheckTypeSetElemAttr(vulnerabilityResourceName, "cwes.*", "94"),
+					resource.TestCheckResourceAttr(vulnerabilityResourceName, "cvss_v3_base_score", "9.8"),
+					resource.TestCheckResourceAttr(vulnerabilityResourceName, "cvss_v2_base_score", "7.5"),
+					resource.TestCheckResourceAttr(vulnerabilityResourceName, "severity", "CRITICAL"),
+					resource.TestCheckResourceAttr(vulnerabilityResourceName, "affected_components.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(vulnerabilityResourceName, "affected_components.*", map[string]string{
+						"identity_type":           "PURL",
+						"identity":                "pkg:maven/com.example/example-lib",
+						"version_type":            "RANGE",
+						"version_start_including": "1.0.0",
+						"version_end_excluding":   "1.2.3",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(vulnerabilityResourceName, "affected_components.*", map[string]string{
+						"identity_type": "PURL",
+						"identity":      "pkg:maven/com.example/example-lib@0.9.0",
+						"version_type":  "EXACT",
+						"version":       "0.9.0",
+					}),
+				),
+			},
+			{
+				ResourceName:      vulnerabilityResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccVulnerabilityConfigOWASP(testDependencyTrack, vulnID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					vulnerabilitytestutils.TestAccCheckVulnerabilityExistsAndHasExpectedSeverity(ctx, testDependencyTrack, vulnerabilityResourceName, "INFO"),
+					resource.TestCheckNoResourceAttr(vulnerabilityResourceName, "cvss_v3_base_score"),
+					resource.TestCheckNoResourceAttr(vulnerabilityResourceName, "cvss_v2_base_score"),
+					resource.TestCheckNoResourceAttr(vulnerabilityResourceName, "affected_components"),
+					resource.TestCheckResourceAttr(vulnerabilityResourceName, "severity", "INFO"),
+				),
+			},
+			{
+				Config: testAccVulnerabilityConfigSeverity(testDependencyTrack, vulnID, "HIGH"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					vulnerabilitytestutils.TestAccCheckVulnerabilityExistsAndHasExpectedSeverity(ctx, testDependencyTrack, vulnerabilityResourceName, "HIGH"),
+					resource.TestCheckNoResourceAttr(vulnerabilityResourceName, "owasp_rr_vector"),
+					resource.TestCheckResourceAttr(vulnerabilityResourceName, "severity", "HIGH"),
+				),
+			},
+			{
+				Config: testAccVulnerabilityConfigMinimal(testDependencyTrack, vulnID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					vulnerabilitytestutils.TestAccCheckVulnerabilityExistsAndHasExpectedSeverity(ctx, testDependencyTrack, vulnerabilityResourceName, "UNASSIGNED"),
+					resource.TestCheckNoResourceAttr(vulnerabilityResourceName, "title"),
+					resource.TestCheckResourceAttr(vulnerabilityResourceName, "severity", "UNASSIGNED"),
+				),
+			},
+		},
+		CheckDestroy: func(state *terraform.State) error {
+			return vulnerabilitytestutils.TestAccCheckVulnerabilityDoesNotExists(ctx, testDependencyTrack, vulnerabilityResourceName)(state)
+		},
+	})
+}
+
+func TestAccVulnerabilityResource_invalidVector(t *testing.T) {
+	vulnID := acctest.RandomWithPrefix("INT")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccVulnerabilityConfigCVSSv3(testDependencyTrack, vulnID, "CVSS:3.1/AV:N/AC:L"),
+				ExpectError: regexp.MustCompile("Invalid Vector"),
+			},
+			{
+				Config:      testAccVulnerabilityConfigSeverityAndVector(testDependencyTrack, vulnID),
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+		},
+	})
+}
+
+func TestAccVulnerabilityResource_cvssV2VectorParentheses(t *testing.T) {
+	ctx := testutils.CreateTestContext(t)
+
+	vulnID := acctest.RandomWithPrefix("INT")
+	vulnerabilityResourceName := vulnerabilitytestutils.CreateVulnerabilityResourceName("test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testutils.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: testutils.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVulnerabilityConfigCVSSv2(testDependencyTrack, vulnID, "AV:N/AC:L/Au:N/C:P/I:P/A:P"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					vulnerabilitytestutils.TestAccCheckVulnerabilityExistsAndHasExpectedSeverity(ctx, testDependencyTrack, vulnerabilityResourceName, "HIGH"),
+					resource.TestCheckResourceAttr(vulnerabilityResourceName, "cvss_v2_vector", "AV:N/AC:L/Au:N/C:P/I:P/A:P"),
+					resource.TestCheckResourceAttr(vulnerabilityResourceName, "cvss_v2_base_score", "7.5"),
+				),
+			},
+			{
+				Config:   testAccVulnerabilityConfigCVSSv2(testDependencyTrack, vulnID, "AV:N/AC:L/Au:N/C:P/I:P/A:P"),
+				PlanOnly: true,
+			},
+			{
+				Config: testAccVulnerabilityConfigCVSSv2(testDependencyTrack, vulnID, "(AV:N/AC:L/Au:N/C:P/I:P/A:P)"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(vulnerabilityResourceName, "cvss_v2_vector", "(AV:N/AC:L/Au:N/C:P/I:P/A:P)"),
+				),
+			},
+			{
+				Config:   testAccVulnerabilityConfigCVSSv2(testDependencyTrack, vulnID, "(AV:N/AC:L/Au:N/C:P/I:P/A:P)"),
+				PlanOnly: true,
+			},
+		},
+		CheckDestroy: func(state *terraform.State) error {
+			return vulnerabilitytestutils.TestAccCheckVulnerabilityDoesNotExists(ctx, testDependencyTrack, vulnerabilityResourceName)(state)
+		},
+	})
+}
+
+func testAccVulnerabilityConfigBasic(testDependencyTrack *testutils.TestDependencyTrack, vulnID string) string {
+	return testDependencyTrack.AddProviderConfiguration(
+		fmt.Sprintf(`
+resource "dependencytrack_vulnerability" "test" {
+	vuln_id        = %[1]q
+	title          = "Remote code execution"
+	description    = "Crafted input allows remote code execution."
+	recommendation = "Upgrade to 1.2.3 or later."
+	cwes           = [94]
+	cvss_v2_vector = "AV:N/AC:L/Au:N/C:P/I:P/A:P"
+	cvss_v3_vector = "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H"
+
+	affected_components = [
+		{
+			identity_type           = "PURL"
+			identity                = "pkg:maven/com.example/example-lib"
+			version_type            = "RANGE"
+			version_start_including = "1.0.0"
+			version_end_excluding   = "1.2.3"
+		},
+		{
+			identity_type = "PURL"
+			identity      = "pkg:maven/com.example/example-lib@0.9.0"
+			version_type  = "EXACT"
+			version       = "0.9.0"
+		},
+	]
+}
+`,
+			vulnID,
+		),
+	)
+}
+
+func testAccVulnerabilityConfigOWASP(testDependencyTrack *testutils.TestDependencyTrack, vulnID string) string {
+	return testDependencyTrack.AddProviderConfiguration(
+		fmt.Sprintf(`
+resource "dependencytrack_vulnerability" "test" {
+	vuln_id         = %[1]q
+	title           = "Remote code execution"
+	owasp_rr_vector = "SL:1/M:1/O:0/S:2/ED:1/EE:1/A:1/ID:1/LC:2/LI:1/LAV:1/LAC:1/FD:1/RD:1/NC:2/PV:3"
+}
+`,
+			vulnID,
+		),
+	)
+}
+
+func testAccVulnerabilityConfigSeverity(testDependencyTrack *testutils.TestDependencyTrack, vulnID, severity string) string {
+	return testDependencyTrack.AddProviderConfiguration(
+		fmt.Sprintf(`
+resource "dependencytrack_vulnerability" "test" {
+	vuln_id  = %[1]q
+	title    = "Remote code execution"
+	severity = %[2]q
+}
+`,
+			vulnID, severity,
+		),
+	)
+}
+
+func testAccVulnerabilityConfigMinimal(testDependencyTrack *testutils.TestDependencyTrack, vulnID string) string {
+	return testDependencyTrack.AddProviderConfiguration(
+		fmt.Sprintf(`
+resource "dependencytrack_vulnerability" "test" {
+	vuln_id = %[1]q
+}
+`,
+			vulnID,
+		),
+	)
+}
+
+func testAccVulnerabilityConfigCVSSv2(testDependencyTrack *testutils.TestDependencyTrack, vulnID, vector string) string {
+	return testDependencyTrack.AddProviderConfiguration(
+		fmt.Sprintf(`
+resource "dependencytrack_vulnerability" "test" {
+	vuln_id        = %[1]q
+	cvss_v2_vector = %[2]q
+}
+`,
+			vulnID, vector,
+		),
+	)
+}
+
+func testAccVulnerabilityConfigCVSSv3(testDependencyTrack *testutils.TestDependencyTrack, vulnID, vector string) string {
+	return testDependencyTrack.AddProviderConfiguration(
+		fmt.Sprintf(`
+resource "dependencytrack_vulnerability" "test" {
+	vuln_id        = %[1]q
+	cvss_v3_vector = %[2]q
+}
+`,
+			vulnID, vector,
+		),
+	)
+}
+
+func testAccVulnerabilityConfigSeverityAndVector(testDependencyTrack *testutils.TestDependencyTrack, vulnID string) string {
+	return testDependencyTrack.AddProviderConfiguration(
+		fmt.Sprintf(`
+resource "dependencytrack_vulnerability" "test" {
+	vuln_id        = %[1]q
+	severity       = "LOW"
+	cvss_v3_vector = "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H"
+}
+`,
+			vulnID,
+		),
+	)
+}
@@ -0,0 +1,247 @@
+// Copyright (c) 2024 Futurice Oy
+// SPDX-License-Identifier: MPL-2.0
+
+package vulnerability
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// The scores and the severity are calculated locally, like Dependency-Track calculates them from the vectors, so
+// that they are known when planning. Only the base metrics affect the scores.
+
+var severities = []string{"CRITICAL", "HIGH", "MEDIUM", "LOW", "INFO", "UNASSIGNED"}
+
+var cvssV2Weights = map[string]map[string]float64{
+	"AV": {"L": 0.395, "A": 0.646, "N": 1.0},
+	"AC": {"H": 0.35, "M": 0.61, "L": 0.71},
+	"Au": {"M": 0.45, "S": 0.56, "N": 0.704},
+	"C":  {"N": 0.0, "P": 0.275, "C": 0.660},
+	"I":  {"N": 0.0, "P": 0.275, "C": 0.660},
+	"A":  {"N": 0.0, "P": 0.275, "C": 0.660},
+}
+
+var cvssV3Weights = map[string]map[string]float64{
+	"AV": {"N": 0.85, "A": 0.62, "L": 0.55, "P": 0.2},
+	"AC": {"L": 0.77, "H": 0.44},
+	"UI": {"N": 0.85, "R": 0.62},
+	"C":  {"H": 0.56, "L": 0.22, "N": 0.0},
+	"I":  {"H": 0.56, "L": 0.22, "N": 0.0},
+	"A":  {"H": 0.56, "L": 0.22, "N": 0.0},
+}
+
+// cvssV3PrivilegesRequiredWeights are the weights of the privileges required, which depend on whether the scope
+// is changed.
+var cvssV3PrivilegesRequiredWeights = map[bool]map[string]float64{
+	false: {"N": 0.85, "L": 0.62, "H": 0.27},
+	true:  {"N": 0.85, "L": 0.68, "H": 0.5},
+}
+
+var owaspRiskRatingFactors = []string{"SL", "M", "O", "S", "ED", "EE", "A", "ID", "LC", "LI", "LAV", "LAC", "FD", "RD", "NC", "PV"}
+
+// CVSSv2BaseScore calculates the base score of a CVSS v2 vector, see https://www.first.org/cvss/v2/guide.
+func CVSSv2BaseScore(vector string) (float64, error) {
+	metrics, err := parseVector(strings.Trim(vector, "()"))
+	if err != nil {
+		return 0, err
+	}
+
+	weights, err := metricWeights(metrics, cvssV2Weights)
+	if err != nil {
+		return 0, err
+	}
+
+	impact := 10.41 * (1 - (1-weights["C"])*(1-weights["I"])*(1-weights["A"]))
+	exploitability := 20 * weights["AV"] * weights["AC"] * weights["Au"]
+
+	f := 1.176
+	if impact == 0 {
+		f = 0
+	}
+
+	return math.Round(((0.6*impact)+(0.4*exploitability)-1.5)*f*10) / 10, nil
+}
+
+// CVSSv3BaseScore calculates the base score of a CVSS v3.0 or v3.1 vector, see
+// https://www.first.org/cvss/v3.1/specification-document. The versions differ only in how the score is rounded.
+func CVSSv3BaseScore(vector string) (float64, error) {
+	version, metricsVector, found := strings.Cut(vector, "/")
+
+	var roundUp func(float64) float64
+	switch {
+	case found && version == "CVSS:3.0":
+		roundUp = cvssV30RoundUp
+	case found && version == "CVSS:3.1":
+		roundUp = cvssV3RoundUp
+	default:
+		return 0, fmt.Errorf("unsupported CVSS version in vector %s", vector)
+	}
+
+	metrics, err := parseVector(metricsVector)
+	if err != nil {
+		return 0, err
+	}
+
+	weights, err := metricWeights(metrics, cvssV3Weights)
+	if err != nil {
+		return 0, err
+	}
+
+	scopeChanged := metrics["S"] == "C"
+	if !scopeChanged && metrics["S"] != "U" {
+		return 0, fmt.Errorf("invalid value for metric S in vector %s", vector)
+	}
+
+	privilegesRequired, ok := cvssV3PrivilegesRequiredWeights[scopeChanged][metrics["PR"]]
+	if !ok {
+		return 0, fmt.Errorf("invalid value for metric PR in vector %s", vector)
+	}
+
+	iss := 1 - (1-weights["C"])*(1-weights["I"])*(1-weights["A"])
+
+	var impact float64
+	if scopeChanged {
+		impact = 7.52*(iss-0.029) - 3.25*math.Pow(iss-0.02, 15)
+	} else {
+		impact = 6.42 * iss
+	}
+
+	exploitability := 8.22 * weights["AV"] * weights["AC"] * privilegesRequired * weights["UI"]
+
+	if impact <= 0 {
+		return 0, nil
+	}
+
+	if scopeChanged {
+		return roundUp(math.Min(1.08*(impact+exploitability), 10)), nil
+	}
+
+	return roundUp(math.Min(impact+exploitability, 10)), nil
+}
+
+// cvssV30RoundUp rounds up to one decimal as specified by CVSS v3.0, i.e. without correcting floating point errors.
+func cvssV30RoundUp(value float64) float64 {
+	return math.Ceil(value*10) / 10
+}
+
+// cvssV3RoundUp rounds up to one decimal, avoiding floating point errors as specified by CVSS v3.1.
+func cvssV3RoundUp(value float64) float64 {
+	intValue := int64(math.Round(value * 100000))
+	if intValue%10000 == 0 {
+		return float64(intValue) / 100000
+	}
+
+	return float64(intValue/10000+1) / 10
+}
+
+// OWASPRiskRatingScores calculates the likelihood, technical impact and business impact scores of an OWASP Risk
+// Rating vector, see https://owasp.org/www-community/OWASP_Risk_Rating_Methodology.
+func OWASPRiskRatingScores(vector string) (likelihood, technicalImpact, businessImpact float64, err error) {
+	metrics, err := parseVector(vector)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	values := make([]float64, len(owaspRiskRatingFactors))
+	for i, factor := range owaspRiskRatingFactors {
+		value, err := strconv.Atoi(metrics[factor])
+		if err != nil || value < 0 || value > 9 {
+			return 0, 0, 0, fmt.Errorf("invalid value for factor %s in vector %s", factor, vector)
+		}
+		values[i] = float64(value)
+	}
+
+	return average(values[0:8]), average(values[8:12]), average(values[12:16]), nil
+}
+
+// CVSSv2Severity returns the severity of a CVSS v2 base score. CVSS v2 has no critical severity.
+func CVSSv2Severity(score float64) string {
+	switch {
+	case score >= 7:
+		return "HIGH"
+	case score >= 4:
+		return "MEDIUM"
+	case score > 0:
+		return "LOW"
+	default:
+		return "INFO"
+	}
+}
+
+// CVSSv3Severity returns the severity of a CVSS v3 base score.
+func CVSSv3Severity(score float64) string {
+	switch {
+	case score >= 9:
+		return "CRITICAL"
+	case score >= 7:
+		return "HIGH"
+	case score >= 4:
+		return "MEDIUM"
+	case score > 0:
+		return "LOW"
+	default:
+		return "INFO"
+	}
+}
+
+// OWASPRiskRatingSeverity returns the overall severity of the likelihood and the higher of the impacts, following
+// the severity matrix of the methodology.
+func OWASPRiskRatingSeverity(likelihood, technicalImpact, businessImpact float64) string {
+	matrix := [3][3]string{
+		{"INFO", "LOW", "MEDIUM"},
+		{"LOW", "MEDIUM", "HIGH"},
+		{"MEDIUM", "HIGH", "CRITICAL"},
+	}
+
+	return matrix[owaspRiskRatingLevel(likelihood)][owaspRiskRatingLevel(math.Max(technicalImpact, businessImpact))]
+}
+
+// owaspRiskRatingLevel returns 0 for a low, 1 for a medium and 2 for a high score.
+func owaspRiskRatingLevel(score float64) int {
+	switch {
+	case score < 3:
+		return 0
+	case score < 6:
+		return 1
+	default:
+		return 2
+	}
+}
+
+func parseVector(vector string) (map[string]string, error) {
+	metrics := map[string]string{}
+	for _, part := range strings.Split(vector, "/") {
+		name, value, found := strings.Cut(part, ":")
+		if !found {
+			return nil, fmt.Errorf("invalid metric %s in vector %s", part, vector)
+		}
+		metrics[name] = value
+	}
+
+	return metrics, nil
+}
+
+func metricWeights(metrics map[string]string, weights map[string]map[string]float64) (map[string]float64, error) {
+	result := make(map[string]float64, len(weights))
+	for name, values := range weights {
+		weight, ok := values[metrics[name]]
+		if !ok {
+			return nil, fmt.Errorf("missing or invalid value for metric %s", name)
+		}
+		result[name] = weight
+	}
+
+	return result, nil
+}
+
+func average(values []float64) float64 {
+	sum := 0.0
+	for _, value := range values {
+		sum += value
+	}
+
+	return sum / float64(len(values))
+}
@@ -0,0 +1,113 @@
+// Copyright (c) 2024 Futurice Oy
+// SPDX-License-Identifier: MPL-2.0
+
+package vulnerability_test
+
+import (
+	"testing"
+
+	"github.com/futurice/terraform-provider-dependencytrack/internal/provider/vulnerability"
+)
+
+func TestCVSSv2BaseScore(t *testing.T) {
+	testCases := map[string]float64{
+		"AV:N/AC:L/Au:N/C:P/I:P/A:P":                  7.5,
+		"(AV:N/AC:L/Au:N/C:C/I:C/A:C)":                10.0,
+		"AV:N/AC:M/Au:N/C:N/I:P/A:N":                  4.3,
+		"AV:L/AC:H/Au:M/C:P/I:N/A:N":                  0.8,
+		"AV:N/AC:L/Au:N/C:N/I:N/A:N":                  0.0,
+		"AV:N/AC:L/Au:N/C:P/I:P/A:P/E:POC/RL:OF/RC:C": 7.5,
+	}
+
+	for vector, expected := range testCases {
+		score, err := vulnerability.CVSSv2BaseScore(vector)
+		if err != nil {
+			t.Errorf("Unexpected error for %s: %v", vector, err)
+			continue
+		}
+
+		if score != expected {
+			t.Errorf("Expected score %.1f for %s, got %.1f", expected, vector, score)
+		}
+	}
+}
+
+func TestCVSSv3BaseScore(t *testing.T) {
+	testCases := map[string]float64{
+		"CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H":               9.8,
+		"CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:C/C:H/I:H/A:H":               10.0,
+		"CVSS:3.1/AV:N/AC:L/PR:L/UI:N/S:U/C:H/I:N/A:N":               6.5,
+		"CVSS:3.1/AV:L/AC:L/PR:L/UI:N/S:U/C:H/I:H/A:H":               7.8,
+		"CVSS:3.1/AV:N/AC:L/PR:N/UI:R/S:C/C:L/I:L/A:N":               6.1,
+		"CVSS:3.0/AV:P/AC:H/PR:H/UI:R/S:U/C:L/I:N/A:N":               1.6,
+		"CVSS:3.0/AV:N/AC:L/PR:N/UI:N/S:U/C:N/I:N/A:N":               0.0,
+		"CVSS:3.0/AV:N/AC:L/PR:N/UI:N/S:C/C:H/I:H/A:H":               10.0,
+		"CVSS:3.0/AV:N/AC:L/PR:N/UI:R/S:C/C:L/I:L/A:N":               6.1,
+		"CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H/E:F/RL:O/RC:C": 9.8,
+	}
+
+	for vector, expected := range testCases {
+		score, err := vulnerability.CVSSv3BaseScore(vector)
+		if err != nil {
+			t.Errorf("Unexpected error for %s: %v", vector, err)
+			continue
+		}
+
+		if score != expected {
+			t.Errorf("Expected score %.1f for %s, got %.1f", expected, vector, score)
+		}
+	}
+}
+
+func TestCVSSBaseScore_invalid(t *testing.T) {
+	if _, err := vulnerability.CVSSv2BaseScore("AV:N/AC:L/Au:N/C:P/I:P"); err == nil {
+		t.Errorf("Error expected for an incomplete CVSS v2 vector, but received none")
+	}
+
+	if _, err := vulnerability.CVSSv3BaseScore("CVSS:4.0/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H"); err == nil {
+		t.Errorf("Error expected for an unsupported CVSS version, but received none")
+	}
+
+	if _, err := vulnerability.CVSSv3BaseScore("CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:X/C:H/I:H/A:H"); err == nil {
+		t.Errorf("Error expected for an invalid scope, but received none")
+	}
+}
+
+func TestOWASPRiskRatingScores(t *testing.T) {
+	likelihood, technicalImpact, businessImpact, err := vulnerability.OWASPRiskRatingScores("SL:1/M:1/O:0/S:2/ED:1/EE:1/A:1/ID:1/LC:2/LI:1/LAV:1/LAC:1/FD:1/RD:1/NC:2/PV:3")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if likelihood != 1.0 || technicalImpact != 1.25 || businessImpact != 1.75 {
+		t.Errorf("Unexpected scores: likelihood %.3f, technical impact %.3f, business impact %.3f", likelihood, technicalImpact, businessImpact)
+	}
+}
+
+func TestSeverities(t *testing.T) {
+	testCases := []struct {
+		name     string
+		actual   string
+		expected string
+	}{
+		{"CVSS v2 high", vulnerability.CVSSv2Severity(10.0), "HIGH"},
+		{"CVSS v2 medium", vulnerability.CVSSv2Severity(4.0), "MEDIUM"},
+		{"CVSS v2 low", vulnerability.CVSSv2Severity(0.8), "LOW"},
+		{"CVSS v2 info", vulnerability.CVSSv2Severity(0.0), "INFO"},
+		{"CVSS v3 critical", vulnerability.CVSSv3Severity(9.0), "CRITICAL"},
+		{"CVSS v3 high", vulnerability.CVSSv3Severity(8.9), "HIGH"},
+		{"CVSS v3 medium", vulnerability.CVSSv3Severity(4.0), "MEDIUM"},
+		{"CVSS v3 low", vulnerability.CVSSv3Severity(0.1), "LOW"},
+		{"CVSS v3 info", vulnerability.CVSSv3Severity(0.0), "INFO"},
+		{"OWASP low likelihood, low impact", vulnerability.OWASPRiskRatingSeverity(1, 1, 2), "INFO"},
+		{"OWASP low likelihood, high business impact", vulnerability.OWASPRiskRatingSeverity(1, 1, 7), "MEDIUM"},
+		{"OWASP medium likelihood, medium impact", vulnerability.OWASPRiskRatingSeverity(3, 5.5, 0), "MEDIUM"},
+		{"OWASP high likelihood, high impact", vulnerability.OWASPRiskRatingSeverity(6, 9, 9), "CRITICAL"},
+	}
+
+	for _, testCase := range testCases {
+		if testCase.actual != testCase.expected {
+			t.Errorf("%s: expected %s, got %s", testCase.name, testCase.expected, testCase.actual)
+		}
+	}
+}
@@ -0,0 +1,51 @@
+// Copyright (c) 2024 Futurice Oy
+// SPDX-License-Identifier: MPL-2.0
+
+package providerdata
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// ResourceBase is embedded in resources to configure them with the provider data. The client and the other
+// services of ProviderData are then available directly on the resource, e.g. r.Client.
+type ResourceBase struct {
+	*ProviderData
+}
+
+func (b *ResourceBase) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	b.ProviderData = fromConfigureRequest(req.ProviderData, "Resource", &resp.Diagnostics)
+}
+
+// DataSourceBase is embedded in data sources to configure them with the provider data, like ResourceBase.
+type DataSourceBase struct {
+	*ProviderData
+}
+
+func (b *DataSourceBase) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	b.ProviderData = fromConfigureRequest(req.ProviderData, "Data Source", &resp.Diagnostics)
+}
+
+func fromConfigureRequest(providerData any, kind string, diags *diag.Diagnostics) *ProviderData {
+	// The provider data is nil until the provider has been configured
+	if providerData == nil {
+		return nil
+	}
+
+	data, ok := providerData.(*ProviderData)
+	if !ok {
+		diags.AddError(
+			fmt.Sprintf("Unexpected %s Configure Type", kind),
+			fmt.Sprintf("Expected *providerdata.ProviderData, got: %T. Please report this issue to the provider developers.", providerData),
+		)
+
+		return nil
+	}
+
+	return data
+}
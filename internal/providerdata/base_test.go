@@ -0,0 +1,55 @@
+// Copyright (c) 2024 Futurice Oy
+// SPDX-License-Identifier: MPL-2.0
+
+package providerdata_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/futurice/terraform-provider-dependencytrack/internal/providerdata"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func TestResourceBase_Configure(t *testing.T) {
+	data := &providerdata.ProviderData{DefaultTags: []string{"terraform"}}
+	base := providerdata.ResourceBase{}
+
+	resp := resource.ConfigureResponse{}
+	base.Configure(context.Background(), resource.ConfigureRequest{ProviderData: data}, &resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Unexpected error: %v", resp.Diagnostics)
+	}
+
+	if base.ProviderData != data || base.DefaultTags[0] != "terraform" {
+		t.Errorf("Provider data not available on the resource")
+	}
+}
+
+func TestResourceBase_Configure_notConfigured(t *testing.T) {
+	base := providerdata.ResourceBase{}
+
+	resp := resource.ConfigureResponse{}
+	base.Configure(context.Background(), resource.ConfigureRequest{}, &resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Errorf("Unexpected error: %v", resp.Diagnostics)
+	}
+}
+
+func TestDataSourceBase_Configure_unexpectedType(t *testing.T) {
+	base := providerdata.DataSourceBase{}
+
+	resp := datasource.ConfigureResponse{}
+	base.Configure(context.Background(), datasource.ConfigureRequest{ProviderData: "client"}, &resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Fatalf("Error expected, but received none")
+	}
+
+	if resp.Diagnostics[0].Summary() != "Unexpected Data Source Configure Type" {
+		t.Errorf("Unexpected error: %v", resp.Diagnostics)
+	}
+}
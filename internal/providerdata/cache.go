@@ -0,0 +1,78 @@
+// Copyright (c) 2024 Futurice Oy
+// SPDX-License-Identifier: MPL-2.0
+
+package providerdata
+
+import (
+	"fmt"
+	"sync"
+)
+
+// Cache holds values shared by the resources and data sources during a single Terraform run, e.g. lists which
+// would otherwise be fetched from the API for every resource. It is safe for concurrent use.
+type Cache struct {
+	mutex   sync.Mutex
+	entries map[string]*cacheEntry
+}
+
+type cacheEntry struct {
+	once  sync.Once
+	value any
+	err   error
+}
+
+func NewCache() *Cache {
+	return &Cache{
+		entries: map[string]*cacheEntry{},
+	}
+}
+
+// Invalidate removes the value of key, e.g. after a resource has changed the underlying data.
+func (c *Cache) Invalidate(key string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	delete(c.entries, key)
+}
+
+func (c *Cache) entry(key string) *cacheEntry {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok {
+		entry = &cacheEntry{}
+		c.entries[key] = entry
+	}
+
+	return entry
+}
+
+// CacheLoad returns the value of key from the cache, calling load to get it if it is missing. Concurrent calls
+// for the same key wait for a single call of load. Errors are not cached.
+func CacheLoad[T any](c *Cache, key string, load func() (T, error)) (T, error) {
+	entry := c.entry(key)
+
+	entry.once.Do(func() {
+		entry.value, entry.err = load()
+	})
+
+	if entry.err != nil {
+		c.mutex.Lock()
+		if c.entries[key] == entry {
+			delete(c.entries, key)
+		}
+		c.mutex.Unlock()
+
+		var zero T
+		return zero, entry.err
+	}
+
+	value, ok := entry.value.(T)
+	if !ok {
+		var zero T
+		return zero, fmt.Errorf("cached value of %s has unexpected type %T", key, entry.value)
+	}
+
+	return value, nil
+}
@@ -0,0 +1,88 @@
+// Copyright (c) 2024 Futurice Oy
+// SPDX-License-Identifier: MPL-2.0
+
+package providerdata_test
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/futurice/terraform-provider-dependencytrack/internal/providerdata"
+)
+
+func TestCacheLoad(t *testing.T) {
+	cache := providerdata.NewCache()
+
+	var loads atomic.Int32
+	load := func() ([]string, error) {
+		loads.Add(1)
+		return []string{"BOM_UPLOAD", "PORTFOLIO_MANAGEMENT"}, nil
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			value, err := providerdata.CacheLoad(cache, "permissions", load)
+			if err != nil || len(value) != 2 {
+				t.Errorf("Unexpected result: %v, %v", value, err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if loads.Load() != 1 {
+		t.Errorf("Expected a single load, got %d", loads.Load())
+	}
+}
+
+func TestCacheLoad_errorNotCached(t *testing.T) {
+	cache := providerdata.NewCache()
+
+	_, err := providerdata.CacheLoad(cache, "permissions", func() ([]string, error) {
+		return nil, errors.New("server unavailable")
+	})
+	if err == nil {
+		t.Fatalf("Error expected, but received none")
+	}
+
+	value, err := providerdata.CacheLoad(cache, "permissions", func() ([]string, error) {
+		return []string{"BOM_UPLOAD"}, nil
+	})
+	if err != nil || len(value) != 1 {
+		t.Errorf("Unexpected result after a failed load: %v, %v", value, err)
+	}
+}
+
+func TestCacheInvalidate(t *testing.T) {
+	cache := providerdata.NewCache()
+
+	loads := 0
+	load := func() (int, error) {
+		loads++
+		return loads, nil
+	}
+
+	first, _ := providerdata.CacheLoad(cache, "key", load)
+	cache.Invalidate("key")
+	second, _ := providerdata.CacheLoad(cache, "key", load)
+
+	if first != 1 || second != 2 {
+		t.Errorf("Expected the value to be loaded again after invalidation, got %d and %d", first, second)
+	}
+}
+
+func TestCacheLoad_unexpectedType(t *testing.T) {
+	cache := providerdata.NewCache()
+
+	_, _ = providerdata.CacheLoad(cache, "key", func() (int, error) { return 1, nil })
+	_, err := providerdata.CacheLoad(cache, "key", func() (string, error) { return "", nil })
+
+	if err == nil {
+		t.Errorf("Error expected, but received none")
+	}
+}
@@ -0,0 +1,117 @@
+// Copyright (c) 2024 Futurice Oy
+// SPDX-License-Identifier: MPL-2.0
+
+// Package providerdata contains the data the provider shares with its resources and data sources.
+package providerdata
+
+import (
+	"fmt"
+	"time"
+
+	dtrack "github.com/futurice/dependency-track-client-go"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// ProviderData is passed from the provider to the resources and data sources when they are configured.
+type ProviderData struct {
+	Client *dtrack.Client
+	// ServerVersion is the version of the Dependency-Track API server, or nil if it could not be detected.
+	ServerVersion *version.Version
+	// Cache is shared by all resources and data sources during the run.
+	Cache *Cache
+	// DefaultTags are added to the tags of all projects managed by the provider.
+	DefaultTags []string
+	// Retry contains the retry settings of the client, e.g. for resources waiting for asynchronous changes.
+	Retry RetrySettings
+}
+
+// RetrySettings describes how the client retries failed requests.
+type RetrySettings struct {
+	MaxRetries int
+	WaitMin    time.Duration
+	WaitMax    time.Duration
+}
+
+// ParseServerVersion parses the version reported by the Dependency-Track API server. Suffixes like -SNAPSHOT are
+// ignored, so that development builds are treated like the release they lead to.
+func ParseServerVersion(serverVersion string) (*version.Version, error) {
+	parsed, err := version.NewVersion(serverVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	return parsed.Core(), nil
+}
+
+// ServerVersionAtLeast tells whether the server version is at least minimumVersion. An unknown server version is
+// assumed to be recent enough, so that a failed version detection does not block the use of the provider.
+func (d *ProviderData) ServerVersionAtLeast(minimumVersion string) bool {
+	if d.ServerVersion == nil {
+		return true
+	}
+
+	return d.ServerVersion.GreaterThanOrEqual(version.Must(version.NewVersion(minimumVersion)))
+}
+
+// RequireServerVersion returns an error diagnostic for attributePath if the server version is older than
+// minimumVersion. The feature describes what requires the version, e.g. "The is_latest attribute".
+func (d *ProviderData) RequireServerVersion(attributePath path.Path, feature string, minimumVersion string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if d.ServerVersionAtLeast(minimumVersion) {
+		return diags
+	}
+
+	summary := "Unsupported Dependency-Track version"
+	detail := fmt.Sprintf("%s requires Dependency-Track >= %s, but the server is running version %s.", feature, minimumVersion, d.ServerVersion)
+
+	if attributePath.Equal(path.Empty()) {
+		diags.AddError(summary, detail)
+	} else {
+		diags.AddAttributeError(attributePath, summary, detail)
+	}
+
+	return diags
+}
+
+// WithDefaultTags returns tags with the missing DefaultTags appended.
+func (d *ProviderData) WithDefaultTags(tags []dtrack.Tag) []dtrack.Tag {
+	existing := make(map[string]bool, len(tags))
+	for _, tag := range tags {
+		existing[tag.Name] = true
+	}
+
+	for _, name := range d.DefaultTags {
+		if !existing[name] {
+			tags = append(tags, dtrack.Tag{Name: name})
+			existing[name] = true
+		}
+	}
+
+	return tags
+}
+
+// WithoutDefaultTags returns the names of tags without the DefaultTags, except for those in keep, e.g. because they
+// are also configured explicitly.
+func (d *ProviderData) WithoutDefaultTags(tags []dtrack.Tag, keep []string) []string {
+	kept := make(map[string]bool, len(keep))
+	for _, name := range keep {
+		kept[name] = true
+	}
+
+	defaults := make(map[string]bool, len(d.DefaultTags))
+	for _, name := range d.DefaultTags {
+		defaults[name] = true
+	}
+
+	names := []string{}
+	for _, tag := range tags {
+		if !defaults[tag.Name] || kept[tag.Name] {
+			names = append(names, tag.Name)
+		}
+	}
+
+	return names
+}
@@ -0,0 +1,128 @@
+// Copyright (c) 2024 Futurice Oy
+// SPDX-License-Identifier: MPL-2.0
+
+package providerdata_test
+
+import (
+	"testing"
+
+	dtrack "github.com/futurice/dependency-track-client-go"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/providerdata"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+func TestParseServerVersion(t *testing.T) {
+	testCases := map[string]string{
+		"4.13.2":          "4.13.2",
+		"4.12.0-SNAPSHOT": "4.12.0",
+		"5.0.0-rc.1":      "5.0.0",
+	}
+
+	for serverVersion, expected := range testCases {
+		parsed, err := providerdata.ParseServerVersion(serverVersion)
+		if err != nil {
+			t.Errorf("Unexpected error for [%s]: %v", serverVersion, err)
+			continue
+		}
+
+		if parsed.String() != expected {
+			t.Errorf("Expected [%s] for [%s], got [%s]", expected, serverVersion, parsed)
+		}
+	}
+}
+
+func TestParseServerVersion_invalid(t *testing.T) {
+	_, err := providerdata.ParseServerVersion("not a version")
+
+	if err == nil {
+		t.Errorf("Error expected, but received none")
+	}
+}
+
+func TestServerVersionAtLeast(t *testing.T) {
+	data := newProviderData(t, "4.12.1")
+
+	testCases := map[string]bool{
+		"4.11.0": true,
+		"4.12.0": true,
+		"4.12.1": true,
+		"4.12.2": false,
+		"5.0.0":  false,
+	}
+
+	for minimumVersion, expected := range testCases {
+		if actual := data.ServerVersionAtLeast(minimumVersion); actual != expected {
+			t.Errorf("Expected %t for minimum version %s, got %t", expected, minimumVersion, actual)
+		}
+	}
+}
+
+func TestServerVersionAtLeast_unknownVersion(t *testing.T) {
+	data := &providerdata.ProviderData{}
+
+	if !data.ServerVersionAtLeast("99.0.0") {
+		t.Errorf("Unknown server version should be assumed to be recent enough")
+	}
+}
+
+func TestRequireServerVersion(t *testing.T) {
+	data := newProviderData(t, "4.11.7")
+
+	diags := data.RequireServerVersion(path.Root("is_latest"), "The is_latest attribute", "4.12.0")
+
+	if !diags.HasError() {
+		t.Fatalf("Error expected, but received none")
+	}
+
+	expectedDetail := "The is_latest attribute requires Dependency-Track >= 4.12.0, but the server is running version 4.11.7."
+	if diags[0].Detail() != expectedDetail {
+		t.Errorf("Expected detail [%s], got [%s]", expectedDetail, diags[0].Detail())
+	}
+
+	if diags := data.RequireServerVersion(path.Root("is_latest"), "The is_latest attribute", "4.11.0"); diags.HasError() {
+		t.Errorf("Unexpected error: %v", diags)
+	}
+}
+
+func newProviderData(t *testing.T, serverVersion string) *providerdata.ProviderData {
+	t.Helper()
+
+	parsed, err := providerdata.ParseServerVersion(serverVersion)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	return &providerdata.ProviderData{ServerVersion: parsed}
+}
+
+func TestWithDefaultTags(t *testing.T) {
+	data := &providerdata.ProviderData{
+		DefaultTags: []string{"terraform", "team-a"},
+	}
+
+	tags := data.WithDefaultTags([]dtrack.Tag{{Name: "team-a"}, {Name: "production"}})
+
+	var names []string
+	for _, tag := range tags {
+		names = append(names, tag.Name)
+	}
+
+	expected := []string{"team-a", "production", "terraform"}
+	if diff := cmp.Diff(expected, names); diff != "" {
+		t.Errorf("Unexpected tags (-want +got):\n%s", diff)
+	}
+}
+
+func TestWithoutDefaultTags(t *testing.T) {
+	data := &providerdata.ProviderData{
+		DefaultTags: []string{"terraform", "team-a"},
+	}
+
+	names := data.WithoutDefaultTags([]dtrack.Tag{{Name: "team-a"}, {Name: "production"}, {Name: "terraform"}}, []string{"team-a"})
+
+	expected := []string{"team-a", "production"}
+	if diff := cmp.Diff(expected, names); diff != "" {
+		t.Errorf("Unexpected tags (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,102 @@
+// Copyright (c) 2024 Futurice Oy
+// SPDX-License-Identifier: MPL-2.0
+
+package analysistestutils
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	dtrack "github.com/futurice/dependency-track-client-go"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/testutils"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+// Finding identifies a vulnerability of a component in a project.
+type Finding struct {
+	ProjectID       uuid.UUID
+	ComponentID     uuid.UUID
+	VulnerabilityID uuid.UUID
+}
+
+// CreateTestFinding creates a project with a component and an internal vulnerability for the component, which are
+// deleted when the test is done. There are no resources for components, so they cannot be created in the test
+// configuration. A zero Finding is returned if acceptance tests are not enabled, as there is no Dependency-Track to
+// create them in, and resource.Test skips the test anyway.
+func CreateTestFinding(ctx context.Context, t *testing.T, testDependencyTrack *testutils.TestDependencyTrack) Finding {
+	t.Helper()
+
+	if testDependencyTrack == nil {
+		return Finding{}
+	}
+
+	client := testDependencyTrack.Client
+
+	project, err := client.Project.Create(ctx, dtrack.Project{
+		Name:   acctest.RandomWithPrefix("test-project"),
+		Active: true,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create project: %v", err)
+	}
+	t.Cleanup(func() {
+		if err := client.Project.Delete(ctx, project.UUID); err != nil {
+			t.Errorf("Failed to delete project %s: %v", project.UUID, err)
+		}
+	})
+
+	component, err := client.Component.Create(ctx, project.UUID, dtrack.Component{
+		Name:    acctest.RandomWithPrefix("test-component"),
+		Version: "1.0.0",
+	})
+	if err != nil {
+		t.Fatalf("Failed to create component: %v", err)
+	}
+
+	vulnerability, err := client.Vulnerability.Create(ctx, dtrack.Vulnerability{
+		VulnID:   acctest.RandomWithPrefix("INT"),
+		Source:   "INTERNAL",
+		Severity: "HIGH",
+	})
+	if err != nil {
+		t.Fatalf("Failed to create vulnerability: %v", err)
+	}
+	// Cleanups are run in reverse order, so the vulnerability is deleted after the project and its findings
+	t.Cleanup(func() {
+		if err := client.Vulnerability.Delete(ctx, vulnerability.UUID); err != nil {
+			t.Errorf("Failed to delete vulnerability %s: %v", vulnerability.UUID, err)
+		}
+	})
+
+	return Finding{
+		ProjectID:       project.UUID,
+		ComponentID:     component.UUID,
+		VulnerabilityID: vulnerability.UUID,
+	}
+}
+
+func TestAccCheckAnalysisHasExpectedData(ctx context.Context, testDependencyTrack *testutils.TestDependencyTrack, finding Finding, expectedAnalysis dtrack.Analysis) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		analysis, err := testDependencyTrack.Client.Analysis.Get(ctx, finding.ComponentID, finding.ProjectID, finding.VulnerabilityID)
+		if err != nil {
+			return fmt.Errorf("failed to get analysis of finding %v from Dependency-Track: %w", finding, err)
+		}
+
+		diff := cmp.Diff(analysis, expectedAnalysis, cmpopts.IgnoreFields(dtrack.Analysis{}, "Comments"))
+		if diff != "" {
+			return fmt.Errorf("analysis of finding %v is different than expected: %s", finding, diff)
+		}
+
+		return nil
+	}
+}
+
+func CreateAnalysisResourceName(localName string) string {
+	return "dependencytrack_analysis." + localName
+}
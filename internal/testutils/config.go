@@ -3,8 +3,22 @@
 
 package testutils
 
-import "strings"
+import (
+	"strconv"
+	"strings"
+)
 
 func ComposeConfigs(configs ...string) string {
 	return strings.Join(configs, "\n")
 }
+
+// QuoteList returns the values as a comma separated list of quoted strings, to be used inside a list in a
+// configuration.
+func QuoteList(values []string) string {
+	quoted := make([]string, len(values))
+	for i, value := range values {
+		quoted[i] = strconv.Quote(value)
+	}
+
+	return strings.Join(quoted, ", ")
+}
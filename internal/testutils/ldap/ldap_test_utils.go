@@ -0,0 +1,66 @@
+// Copyright (c) 2024 Futurice Oy
+// SPDX-License-Identifier: MPL-2.0
+
+package ldaptestutils
+
+import (
+	"context"
+	"fmt"
+
+	dtrack "github.com/futurice/dependency-track-client-go"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/testutils"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccCheckLDAPMappingExists(ctx context.Context, testDependencyTrack *testutils.TestDependencyTrack, teamResourceName, dn string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		mapping, err := FindLDAPMappingByTeamResourceName(ctx, testDependencyTrack, state, teamResourceName, dn)
+		if err != nil {
+			return err
+		}
+		if mapping == nil {
+			return fmt.Errorf("LDAP group %s is not mapped to team %s in Dependency-Track", dn, teamResourceName)
+		}
+
+		return nil
+	}
+}
+
+func TestAccCheckLDAPMappingDoesNotExists(ctx context.Context, testDependencyTrack *testutils.TestDependencyTrack, teamResourceName, dn string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		mapping, err := FindLDAPMappingByTeamResourceName(ctx, testDependencyTrack, state, teamResourceName, dn)
+		if err != nil {
+			return err
+		}
+		if mapping != nil {
+			return fmt.Errorf("LDAP group %s is mapped to team %s in Dependency-Track, even though it shouldn't be", dn, teamResourceName)
+		}
+
+		return nil
+	}
+}
+
+func FindLDAPMappingByTeamResourceName(ctx context.Context, testDependencyTrack *testutils.TestDependencyTrack, state *terraform.State, teamResourceName, dn string) (*dtrack.LDAPMapping, error) {
+	teamID, err := testutils.GetResourceID(state, teamResourceName)
+	if err != nil {
+		return nil, err
+	}
+
+	mappings, err := testDependencyTrack.Client.LDAP.GetTeamMappings(ctx, teamID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get LDAP mappings of team %s from Dependency-Track: %w", teamID, err)
+	}
+
+	for _, mapping := range mappings {
+		if mapping.DN == dn {
+			return &mapping, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func CreateLDAPMappingResourceName(localName string) string {
+	return "dependencytrack_ldap_mapping." + localName
+}
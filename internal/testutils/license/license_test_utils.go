@@ -0,0 +1,151 @@
+// Copyright (c) 2024 Futurice Oy
+// SPDX-License-Identifier: MPL-2.0
+
+package licensetestutils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	dtrack "github.com/futurice/dependency-track-client-go"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/testutils"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccCheckLicenseGroupExistsAndHasExpectedData(ctx context.Context, testDependencyTrack *testutils.TestDependencyTrack, resourceName string, expectedGroup dtrack.LicenseGroup, expectedLicenseIDs []string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		group, err := FindLicenseGroupByResourceName(ctx, testDependencyTrack, state, resourceName)
+		if err != nil {
+			return err
+		}
+		if group == nil {
+			return fmt.Errorf("license group for resource %s does not exist in Dependency-Track", resourceName)
+		}
+
+		diff := cmp.Diff(group, &expectedGroup, cmpopts.IgnoreFields(dtrack.LicenseGroup{}, "UUID", "Licenses"))
+		if diff != "" {
+			return fmt.Errorf("license group for resource %s is different than expected: %s", resourceName, diff)
+		}
+
+		actualLicenseIDs := make([]string, len(group.Licenses))
+		for i, license := range group.Licenses {
+			actualLicenseIDs[i] = license.LicenseID
+		}
+
+		diff = cmp.Diff(expectedLicenseIDs, actualLicenseIDs, cmpopts.SortSlices(func(a, b string) bool { return a < b }), cmpopts.EquateEmpty())
+		if diff != "" {
+			return fmt.Errorf("licenses of license group for resource %s are different than expected: %s", resourceName, diff)
+		}
+
+		return nil
+	}
+}
+
+func TestAccCheckLicenseGroupDoesNotExists(ctx context.Context, testDependencyTrack *testutils.TestDependencyTrack, resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		group, err := FindLicenseGroupByResourceName(ctx, testDependencyTrack, state, resourceName)
+		if err != nil {
+			return err
+		}
+		if group != nil {
+			return fmt.Errorf("license group for resource %s exists in Dependency-Track, even though it shouldn't: %v", resourceName, group)
+		}
+
+		return nil
+	}
+}
+
+func TestAccCheckLicenseExistsAndHasExpectedData(ctx context.Context, testDependencyTrack *testutils.TestDependencyTrack, expectedLicense dtrack.License) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		license, err := FindLicense(ctx, testDependencyTrack, expectedLicense.LicenseID)
+		if err != nil {
+			return err
+		}
+		if license == nil {
+			return fmt.Errorf("license %s does not exist in Dependency-Track", expectedLicense.LicenseID)
+		}
+
+		diff := cmp.Diff(license, &expectedLicense, cmpopts.IgnoreFields(dtrack.License{}, "UUID", "Template", "Header", "SeeAlso", "IsOSIApproved", "IsFSFLibre", "IsDeprecatedLicenseID"))
+		if diff != "" {
+			return fmt.Errorf("license %s is different than expected: %s", expectedLicense.LicenseID, diff)
+		}
+
+		return nil
+	}
+}
+
+func TestAccCheckLicenseDoesNotExists(ctx context.Context, testDependencyTrack *testutils.TestDependencyTrack, licenseID string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		license, err := FindLicense(ctx, testDependencyTrack, licenseID)
+		if err != nil {
+			return err
+		}
+		if license != nil {
+			return fmt.Errorf("license %s exists in Dependency-Track, even though it shouldn't: %v", licenseID, license)
+		}
+
+		return nil
+	}
+}
+
+func FindLicenseGroupByResourceName(ctx context.Context, testDependencyTrack *testutils.TestDependencyTrack, state *terraform.State, resourceName string) (*dtrack.LicenseGroup, error) {
+	groupID, err := testutils.GetResourceID(state, resourceName)
+	if err != nil {
+		return nil, err
+	}
+
+	group, err := FindLicenseGroup(ctx, testDependencyTrack, groupID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get license group for resource %s: %w", resourceName, err)
+	}
+
+	return group, nil
+}
+
+func FindLicenseGroup(ctx context.Context, testDependencyTrack *testutils.TestDependencyTrack, groupID uuid.UUID) (*dtrack.LicenseGroup, error) {
+	group, err := testDependencyTrack.Client.LicenseGroup.Get(ctx, groupID)
+	if err != nil {
+		var apiErr *dtrack.APIError
+		ok := errors.As(err, &apiErr)
+		if !ok || apiErr.StatusCode != http.StatusNotFound {
+			return nil, fmt.Errorf("failed to get license group from Dependency-Track: %w", err)
+		}
+
+		return nil, nil
+	}
+
+	return &group, nil
+}
+
+func FindLicense(ctx context.Context, testDependencyTrack *testutils.TestDependencyTrack, licenseID string) (*dtrack.License, error) {
+	license, err := testDependencyTrack.Client.License.Get(ctx, licenseID)
+	if err != nil {
+		var apiErr *dtrack.APIError
+		ok := errors.As(err, &apiErr)
+		if !ok || apiErr.StatusCode != http.StatusNotFound {
+			return nil, fmt.Errorf("failed to get license from Dependency-Track: %w", err)
+		}
+
+		return nil, nil
+	}
+
+	return &license, nil
+}
+
+func CreateLicenseGroupResourceName(localName string) string {
+	return "dependencytrack_license_group." + localName
+}
+
+func CreateLicenseResourceName(localName string) string {
+	return "dependencytrack_license." + localName
+}
+
+func CreateLicenseDataSourceName(localName string) string {
+	return "data.dependencytrack_license." + localName
+}
@@ -0,0 +1,149 @@
+// Copyright (c) 2024 Futurice Oy
+// SPDX-License-Identifier: MPL-2.0
+
+package oidctestutils
+
+import (
+	"context"
+	"fmt"
+
+	dtrack "github.com/futurice/dependency-track-client-go"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/testutils"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccCheckOIDCGroupExistsAndHasExpectedName(ctx context.Context, testDependencyTrack *testutils.TestDependencyTrack, resourceName string, expectedName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		groupID, err := testutils.GetResourceID(state, resourceName)
+		if err != nil {
+			return err
+		}
+
+		group, err := FindOIDCGroup(ctx, testDependencyTrack, groupID)
+		if err != nil {
+			return err
+		}
+		if group == nil {
+			return fmt.Errorf("OIDC group for resource %s does not exist in Dependency-Track", resourceName)
+		}
+
+		if group.Name != expectedName {
+			return fmt.Errorf("OIDC group for resource %s has name %s instead of the expected %s", resourceName, group.Name, expectedName)
+		}
+
+		return nil
+	}
+}
+
+func TestAccCheckOIDCGroupDoesNotExists(ctx context.Context, testDependencyTrack *testutils.TestDependencyTrack, resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		groupID, err := testutils.GetResourceID(state, resourceName)
+		if err != nil {
+			return err
+		}
+
+		group, err := FindOIDCGroup(ctx, testDependencyTrack, groupID)
+		if err != nil {
+			return err
+		}
+		if group != nil {
+			return fmt.Errorf("OIDC group for resource %s exists in Dependency-Track, even though it shouldn't: %v", resourceName, group)
+		}
+
+		return nil
+	}
+}
+
+func TestAccCheckOIDCGroupMappingExists(ctx context.Context, testDependencyTrack *testutils.TestDependencyTrack, teamResourceName, groupResourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		mapping, err := FindOIDCGroupMappingForResources(ctx, testDependencyTrack, state, teamResourceName, groupResourceName)
+		if err != nil {
+			return err
+		}
+		if mapping == nil {
+			return fmt.Errorf("OIDC group %s is not mapped to team %s in Dependency-Track", groupResourceName, teamResourceName)
+		}
+
+		return nil
+	}
+}
+
+func TestAccCheckOIDCGroupMappingDoesNotExists(ctx context.Context, testDependencyTrack *testutils.TestDependencyTrack, teamResourceName, groupResourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		mapping, err := FindOIDCGroupMappingForResources(ctx, testDependencyTrack, state, teamResourceName, groupResourceName)
+		if err != nil {
+			return err
+		}
+		if mapping != nil {
+			return fmt.Errorf("OIDC group %s is mapped to team %s in Dependency-Track, even though it shouldn't be", groupResourceName, teamResourceName)
+		}
+
+		return nil
+	}
+}
+
+func FindOIDCGroup(ctx context.Context, testDependencyTrack *testutils.TestDependencyTrack, groupID uuid.UUID) (*dtrack.OIDCGroup, error) {
+	groups, err := dtrack.FetchAll(func(po dtrack.PageOptions) (dtrack.Page[dtrack.OIDCGroup], error) {
+		return testDependencyTrack.Client.OIDC.GetAllGroups(ctx, po)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get OIDC groups from Dependency-Track: %w", err)
+	}
+
+	for _, group := range groups {
+		if group.UUID == groupID {
+			return &group, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func FindOIDCGroupMappingForResources(ctx context.Context, testDependencyTrack *testutils.TestDependencyTrack, state *terraform.State, teamResourceName, groupResourceName string) (*dtrack.OIDCMapping, error) {
+	teamID, err := testutils.GetResourceID(state, teamResourceName)
+	if err != nil {
+		return nil, err
+	}
+
+	groupID, err := testutils.GetResourceID(state, groupResourceName)
+	if err != nil {
+		return nil, err
+	}
+
+	return FindOIDCGroupMapping(ctx, testDependencyTrack, teamID, groupID)
+}
+
+// FindOIDCGroupMapping returns the mapping of the group to the team. Teams are listed in full, as getting a single
+// team does not return the mapped groups.
+func FindOIDCGroupMapping(ctx context.Context, testDependencyTrack *testutils.TestDependencyTrack, teamID, groupID uuid.UUID) (*dtrack.OIDCMapping, error) {
+	teams, err := dtrack.FetchAll(func(po dtrack.PageOptions) (dtrack.Page[dtrack.Team], error) {
+		return testDependencyTrack.Client.Team.GetAll(ctx, po)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get teams from Dependency-Track: %w", err)
+	}
+
+	for _, team := range teams {
+		if team.UUID != teamID {
+			continue
+		}
+
+		for _, mapping := range team.MappedOIDCGroups {
+			if mapping.Group.UUID == groupID {
+				return &mapping, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+func CreateOIDCGroupResourceName(localName string) string {
+	return "dependencytrack_oidc_group." + localName
+}
+
+func CreateOIDCGroupMappingResourceName(localName string) string {
+	return "dependencytrack_oidc_group_mapping." + localName
+}
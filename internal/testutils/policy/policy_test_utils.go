@@ -0,0 +1,174 @@
+// Copyright (c) 2024 Futurice Oy
+// SPDX-License-Identifier: MPL-2.0
+
+package policytestutils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+
+	dtrack "github.com/futurice/dependency-track-client-go"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/testutils"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccCheckPolicyExistsAndHasExpectedData(ctx context.Context, testDependencyTrack *testutils.TestDependencyTrack, resourceName string, expectedPolicy dtrack.Policy) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		policy, err := FindPolicyByResourceName(ctx, testDependencyTrack, state, resourceName)
+		if err != nil {
+			return err
+		}
+		if policy == nil {
+			return fmt.Errorf("policy for resource %s does not exist in Dependency-Track", resourceName)
+		}
+
+		diff := cmp.Diff(policy, &expectedPolicy, cmpopts.IgnoreFields(dtrack.Policy{}, "UUID", "PolicyConditions", "Global", "Projects", "Tags"))
+		if diff != "" {
+			return fmt.Errorf("policy for resource %s is different than expected: %s", resourceName, diff)
+		}
+
+		return nil
+	}
+}
+
+func TestAccCheckPolicyDoesNotExists(ctx context.Context, testDependencyTrack *testutils.TestDependencyTrack, resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		policy, err := FindPolicyByResourceName(ctx, testDependencyTrack, state, resourceName)
+		if err != nil {
+			return err
+		}
+		if policy != nil {
+			return fmt.Errorf("policy for resource %s exists in Dependency-Track, even though it shouldn't: %v", resourceName, policy)
+		}
+
+		return nil
+	}
+}
+
+func TestAccCheckPolicyHasExpectedConditions(ctx context.Context, testDependencyTrack *testutils.TestDependencyTrack, resourceName string, expectedConditions []dtrack.PolicyCondition) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		policy, err := FindPolicyByResourceName(ctx, testDependencyTrack, state, resourceName)
+		if err != nil {
+			return err
+		}
+		if policy == nil {
+			return fmt.Errorf("policy for resource %s does not exist in Dependency-Track", resourceName)
+		}
+
+		sortConditions := cmpopts.SortSlices(func(a, b dtrack.PolicyCondition) bool {
+			return string(a.Subject)+a.Value < string(b.Subject)+b.Value
+		})
+
+		diff := cmp.Diff(expectedConditions, policy.PolicyConditions, sortConditions, cmpopts.EquateEmpty(), cmpopts.IgnoreFields(dtrack.PolicyCondition{}, "UUID", "Policy"))
+		if diff != "" {
+			return fmt.Errorf("conditions of policy for resource %s are different than expected: %s", resourceName, diff)
+		}
+
+		return nil
+	}
+}
+
+func TestAccCheckPolicyHasExpectedProjects(ctx context.Context, testDependencyTrack *testutils.TestDependencyTrack, resourceName string, expectedProjectIDs []*string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		policy, err := FindPolicyByResourceName(ctx, testDependencyTrack, state, resourceName)
+		if err != nil {
+			return err
+		}
+		if policy == nil {
+			return fmt.Errorf("policy for resource %s does not exist in Dependency-Track", resourceName)
+		}
+
+		if len(policy.Projects) != len(expectedProjectIDs) {
+			return fmt.Errorf("policy for resource %s has %d projects instead of the expected %d", resourceName, len(policy.Projects), len(expectedProjectIDs))
+		}
+
+		actualProjectIDs := make([]string, len(policy.Projects))
+		for i, project := range policy.Projects {
+			actualProjectIDs[i] = project.UUID.String()
+		}
+
+		for _, expectedProjectID := range expectedProjectIDs {
+			if !slices.Contains(actualProjectIDs, *expectedProjectID) {
+				return fmt.Errorf("policy for resource %s is missing expected project %s, got [%v]", resourceName, *expectedProjectID, actualProjectIDs)
+			}
+		}
+
+		return nil
+	}
+}
+
+func TestAccCheckPolicyHasExpectedTags(ctx context.Context, testDependencyTrack *testutils.TestDependencyTrack, resourceName string, expectedTags []string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		policy, err := FindPolicyByResourceName(ctx, testDependencyTrack, state, resourceName)
+		if err != nil {
+			return err
+		}
+		if policy == nil {
+			return fmt.Errorf("policy for resource %s does not exist in Dependency-Track", resourceName)
+		}
+
+		actualTags := make([]string, len(policy.Tags))
+		for i, tag := range policy.Tags {
+			actualTags[i] = tag.Name
+		}
+
+		diff := cmp.Diff(expectedTags, actualTags, cmpopts.SortSlices(func(a, b string) bool { return a < b }), cmpopts.EquateEmpty())
+		if diff != "" {
+			return fmt.Errorf("tags of policy for resource %s are different than expected: %s", resourceName, diff)
+		}
+
+		return nil
+	}
+}
+
+func FindPolicyByResourceName(ctx context.Context, testDependencyTrack *testutils.TestDependencyTrack, state *terraform.State, resourceName string) (*dtrack.Policy, error) {
+	policyID, err := testutils.GetResourceID(state, resourceName)
+	if err != nil {
+		return nil, err
+	}
+
+	policy, err := FindPolicy(ctx, testDependencyTrack, policyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get policy for resource %s: %w", resourceName, err)
+	}
+
+	return policy, nil
+}
+
+func FindPolicy(ctx context.Context, testDependencyTrack *testutils.TestDependencyTrack, policyID uuid.UUID) (*dtrack.Policy, error) {
+	policy, err := testDependencyTrack.Client.Policy.Get(ctx, policyID)
+	if err != nil {
+		var apiErr *dtrack.APIError
+		ok := errors.As(err, &apiErr)
+		if !ok || apiErr.StatusCode != http.StatusNotFound {
+			return nil, fmt.Errorf("failed to get policy from Dependency-Track: %w", err)
+		}
+
+		return nil, nil
+	}
+
+	return &policy, nil
+}
+
+func CreatePolicyResourceName(localName string) string {
+	return "dependencytrack_policy." + localName
+}
+
+func CreatePolicyConditionResourceName(localName string) string {
+	return "dependencytrack_policy_condition." + localName
+}
+
+func CreatePolicyProjectResourceName(localName string) string {
+	return "dependencytrack_policy_project." + localName
+}
+
+func CreatePolicyTagResourceName(localName string) string {
+	return "dependencytrack_policy_tag." + localName
+}
@@ -43,6 +43,22 @@ func TestAccCheckProjectExistsAndHasExpectedLazyData(ctx context.Context, testDe
 	}
 }
 
+// WithServerDefaults returns the project with the values Dependency-Track returns for the attributes the test does not
+// set: is_latest is false and the collection logic is NONE.
+func WithServerDefaults(project dtrack.Project) dtrack.Project {
+	if project.IsLatest == nil {
+		isLatest := false
+		project.IsLatest = &isLatest
+	}
+
+	if project.CollectionLogic == nil {
+		collectionLogic := dtrack.CollectionLogic("NONE")
+		project.CollectionLogic = &collectionLogic
+	}
+
+	return project
+}
+
 func TestAccCheckProjectDoesNotExists(ctx context.Context, testDependencyTrack *testutils.TestDependencyTrack, resourceName string) resource.TestCheckFunc {
 	return func(state *terraform.State) error {
 		project, err := FindProjectByResourceName(ctx, testDependencyTrack, state, resourceName)
@@ -86,6 +102,65 @@ func FindProject(ctx context.Context, testDependencyTrack *testutils.TestDepende
 	return &project, nil
 }
 
+func TestAccCheckProjectHasExpectedTags(ctx context.Context, testDependencyTrack *testutils.TestDependencyTrack, resourceName string, expectedTags []string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		project, err := FindProjectByResourceName(ctx, testDependencyTrack, state, resourceName)
+		if err != nil {
+			return err
+		}
+		if project == nil {
+			return fmt.Errorf("project for resource %s does not exist in Dependency-Track", resourceName)
+		}
+
+		actualTags := make([]string, len(project.Tags))
+		for i, tag := range project.Tags {
+			actualTags[i] = tag.Name
+		}
+
+		diff := cmp.Diff(expectedTags, actualTags, cmpopts.SortSlices(func(a, b string) bool { return a < b }), cmpopts.EquateEmpty())
+		if diff != "" {
+			return fmt.Errorf("tags of project for resource %s are different than expected: %s", resourceName, diff)
+		}
+
+		return nil
+	}
+}
+
+func TestAccCheckProjectHasExpectedProperties(ctx context.Context, testDependencyTrack *testutils.TestDependencyTrack, resourceName string, expectedProperties []dtrack.ProjectProperty) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		projectID, err := testutils.GetResourceID(state, resourceName)
+		if err != nil {
+			return err
+		}
+
+		properties, err := dtrack.FetchAll(func(po dtrack.PageOptions) (dtrack.Page[dtrack.ProjectProperty], error) {
+			return testDependencyTrack.Client.ProjectProperty.GetAll(ctx, projectID, po)
+		})
+		if err != nil {
+			return fmt.Errorf("failed to get properties of project for resource %s: %w", resourceName, err)
+		}
+
+		sortProperties := cmpopts.SortSlices(func(a, b dtrack.ProjectProperty) bool {
+			return a.Group+"/"+a.Name < b.Group+"/"+b.Name
+		})
+
+		diff := cmp.Diff(expectedProperties, properties, sortProperties, cmpopts.EquateEmpty())
+		if diff != "" {
+			return fmt.Errorf("properties of project for resource %s are different than expected: %s", resourceName, diff)
+		}
+
+		return nil
+	}
+}
+
 func CreateProjectResourceName(localName string) string {
 	return "dependencytrack_project." + localName
 }
+
+func CreateProjectPropertyResourceName(localName string) string {
+	return "dependencytrack_project_property." + localName
+}
+
+func CreateProjectTagsResourceName(localName string) string {
+	return "dependencytrack_project_tags." + localName
+}
@@ -0,0 +1,85 @@
+// Copyright (c) 2024 Futurice Oy
+// SPDX-License-Identifier: MPL-2.0
+
+package repositorytestutils
+
+import (
+	"context"
+	"fmt"
+
+	dtrack "github.com/futurice/dependency-track-client-go"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/testutils"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccCheckRepositoryExistsAndHasExpectedData(ctx context.Context, testDependencyTrack *testutils.TestDependencyTrack, resourceName string, expectedRepository dtrack.Repository) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		repository, err := FindRepositoryByResourceName(ctx, testDependencyTrack, state, resourceName)
+		if err != nil {
+			return err
+		}
+		if repository == nil {
+			return fmt.Errorf("repository for resource %s does not exist in Dependency-Track", resourceName)
+		}
+
+		diff := cmp.Diff(repository, &expectedRepository, cmpopts.IgnoreFields(dtrack.Repository{}, "UUID", "Password"))
+		if diff != "" {
+			return fmt.Errorf("repository for resource %s is different than expected: %s", resourceName, diff)
+		}
+
+		return nil
+	}
+}
+
+func TestAccCheckRepositoryDoesNotExists(ctx context.Context, testDependencyTrack *testutils.TestDependencyTrack, resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		repository, err := FindRepositoryByResourceName(ctx, testDependencyTrack, state, resourceName)
+		if err != nil {
+			return err
+		}
+		if repository != nil {
+			return fmt.Errorf("repository for resource %s exists in Dependency-Track, even though it shouldn't: %v", resourceName, repository)
+		}
+
+		return nil
+	}
+}
+
+func FindRepositoryByResourceName(ctx context.Context, testDependencyTrack *testutils.TestDependencyTrack, state *terraform.State, resourceName string) (*dtrack.Repository, error) {
+	repositoryID, err := testutils.GetResourceID(state, resourceName)
+	if err != nil {
+		return nil, err
+	}
+
+	repository, err := FindRepository(ctx, testDependencyTrack, repositoryID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get repository for resource %s: %w", resourceName, err)
+	}
+
+	return repository, nil
+}
+
+func FindRepository(ctx context.Context, testDependencyTrack *testutils.TestDependencyTrack, repositoryID uuid.UUID) (*dtrack.Repository, error) {
+	repositories, err := dtrack.FetchAll(func(po dtrack.PageOptions) (dtrack.Page[dtrack.Repository], error) {
+		return testDependencyTrack.Client.Repository.GetAll(ctx, po)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get repositories from Dependency-Track: %w", err)
+	}
+
+	for _, repository := range repositories {
+		if repository.UUID == repositoryID {
+			return &repository, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func CreateRepositoryResourceName(localName string) string {
+	return "dependencytrack_repository." + localName
+}
@@ -157,6 +157,29 @@ func TestAccCheckGetTeamSingleAPIKey(ctx context.Context, testDependencyTrack *t
 	}
 }
 
+// TestAccCheckTeamHasExpectedMembers checks that the users of userType (MANAGED, LDAP or OIDC) in the team are exactly
+// expectedUsernames.
+func TestAccCheckTeamHasExpectedMembers(ctx context.Context, testDependencyTrack *testutils.TestDependencyTrack, resourceName string, userType string, expectedUsernames []string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		teamID, err := testutils.GetResourceID(state, resourceName)
+		if err != nil {
+			return err
+		}
+
+		usernames, err := FindTeamMembers(ctx, testDependencyTrack, teamID, userType)
+		if err != nil {
+			return fmt.Errorf("failed to get members of team for resource %s: %w", resourceName, err)
+		}
+
+		diff := cmp.Diff(expectedUsernames, usernames, cmpopts.SortSlices(func(a, b string) bool { return a < b }), cmpopts.EquateEmpty())
+		if diff != "" {
+			return fmt.Errorf("%s members of team for resource %s are different than expected: %s", userType, resourceName, diff)
+		}
+
+		return nil
+	}
+}
+
 func FindTeamByResourceName(ctx context.Context, testDependencyTrack *testutils.TestDependencyTrack, state *terraform.State, resourceName string) (*dtrack.Team, error) {
 	teamID, err := testutils.GetResourceID(state, resourceName)
 	if err != nil {
@@ -199,6 +222,55 @@ func FindTeam(ctx context.Context, testDependencyTrack *testutils.TestDependency
 	return nil, nil
 }
 
+// FindTeamMembers returns the usernames of the users of userType (MANAGED, LDAP or OIDC) in the team.
+func FindTeamMembers(ctx context.Context, testDependencyTrack *testutils.TestDependencyTrack, teamID uuid.UUID, userType string) ([]string, error) {
+	teamsOfUsers := make(map[string][]dtrack.Team)
+
+	switch userType {
+	case "MANAGED":
+		users, err := dtrack.FetchAll(func(po dtrack.PageOptions) (dtrack.Page[dtrack.ManagedUser], error) {
+			return testDependencyTrack.Client.User.GetAllManaged(ctx, po)
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, user := range users {
+			teamsOfUsers[user.Username] = user.Teams
+		}
+	case "LDAP":
+		users, err := dtrack.FetchAll(func(po dtrack.PageOptions) (dtrack.Page[dtrack.LDAPUser], error) {
+			return testDependencyTrack.Client.User.GetAllLDAP(ctx, po)
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, user := range users {
+			teamsOfUsers[user.Username] = user.Teams
+		}
+	case "OIDC":
+		users, err := dtrack.FetchAll(func(po dtrack.PageOptions) (dtrack.Page[dtrack.OIDCUser], error) {
+			return testDependencyTrack.Client.User.GetAllOIDC(ctx, po)
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, user := range users {
+			teamsOfUsers[user.Username] = user.Teams
+		}
+	default:
+		return nil, fmt.Errorf("unknown user type %s", userType)
+	}
+
+	var usernames []string
+	for username, teams := range teamsOfUsers {
+		if slices.ContainsFunc(teams, func(team dtrack.Team) bool { return team.UUID == teamID }) {
+			usernames = append(usernames, username)
+		}
+	}
+
+	return usernames, nil
+}
+
 func CreateTeamResourceName(localName string) string {
 	return "dependencytrack_team." + localName
 }
@@ -218,3 +290,11 @@ func CreateTeamAPIKeyResourceName(localName string) string {
 func CreateACLMappingResourceName(localName string) string {
 	return "dependencytrack_acl_mapping." + localName
 }
+
+func CreateTeamMembershipResourceName(localName string) string {
+	return "dependencytrack_team_membership." + localName
+}
+
+func CreateTeamMembersResourceName(localName string) string {
+	return "dependencytrack_team_members." + localName
+}
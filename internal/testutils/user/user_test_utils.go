@@ -0,0 +1,239 @@
+// Copyright (c) 2024 Futurice Oy
+// SPDX-License-Identifier: MPL-2.0
+
+package usertestutils
+
+import (
+	"context"
+	"fmt"
+
+	dtrack "github.com/futurice/dependency-track-client-go"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/testutils"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccCheckManagedUserExistsAndHasExpectedData(ctx context.Context, testDependencyTrack *testutils.TestDependencyTrack, expectedUser dtrack.ManagedUser) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		user, err := FindManagedUser(ctx, testDependencyTrack, expectedUser.Username)
+		if err != nil {
+			return err
+		}
+		if user == nil {
+			return fmt.Errorf("managed user %s does not exist in Dependency-Track", expectedUser.Username)
+		}
+
+		diff := cmp.Diff(user, &expectedUser, cmpopts.IgnoreFields(dtrack.ManagedUser{}, "LastPasswordChange", "Teams", "Permissions", "NewPassword", "ConfirmPassword"))
+		if diff != "" {
+			return fmt.Errorf("managed user %s is different than expected: %s", expectedUser.Username, diff)
+		}
+
+		return nil
+	}
+}
+
+func TestAccCheckManagedUserDoesNotExists(ctx context.Context, testDependencyTrack *testutils.TestDependencyTrack, username string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		user, err := FindManagedUser(ctx, testDependencyTrack, username)
+		if err != nil {
+			return err
+		}
+		if user != nil {
+			return fmt.Errorf("managed user %s exists in Dependency-Track, even though it shouldn't: %v", username, user)
+		}
+
+		return nil
+	}
+}
+
+// TestAccCheckManagedUserCanLogIn checks that the user can log in with the password, i.e. that the password was set.
+func TestAccCheckManagedUserCanLogIn(ctx context.Context, testDependencyTrack *testutils.TestDependencyTrack, username, password string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		loginClient, err := dtrack.NewClient(testDependencyTrack.Endpoint)
+		if err != nil {
+			return fmt.Errorf("failed to create Dependency-Track client: %w", err)
+		}
+
+		_, err = loginClient.User.Login(ctx, username, password)
+		if err != nil {
+			return fmt.Errorf("managed user %s could not log in: %w", username, err)
+		}
+
+		return nil
+	}
+}
+
+func TestAccCheckLDAPUserExists(ctx context.Context, testDependencyTrack *testutils.TestDependencyTrack, username string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		user, err := FindLDAPUser(ctx, testDependencyTrack, username)
+		if err != nil {
+			return err
+		}
+		if user == nil {
+			return fmt.Errorf("LDAP user %s does not exist in Dependency-Track", username)
+		}
+
+		return nil
+	}
+}
+
+func TestAccCheckLDAPUserDoesNotExists(ctx context.Context, testDependencyTrack *testutils.TestDependencyTrack, username string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		user, err := FindLDAPUser(ctx, testDependencyTrack, username)
+		if err != nil {
+			return err
+		}
+		if user != nil {
+			return fmt.Errorf("LDAP user %s exists in Dependency-Track, even though it shouldn't: %v", username, user)
+		}
+
+		return nil
+	}
+}
+
+func TestAccCheckOIDCUserExists(ctx context.Context, testDependencyTrack *testutils.TestDependencyTrack, username string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		user, err := FindOIDCUser(ctx, testDependencyTrack, username)
+		if err != nil {
+			return err
+		}
+		if user == nil {
+			return fmt.Errorf("OIDC user %s does not exist in Dependency-Track", username)
+		}
+
+		return nil
+	}
+}
+
+func TestAccCheckOIDCUserDoesNotExists(ctx context.Context, testDependencyTrack *testutils.TestDependencyTrack, username string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		user, err := FindOIDCUser(ctx, testDependencyTrack, username)
+		if err != nil {
+			return err
+		}
+		if user != nil {
+			return fmt.Errorf("OIDC user %s exists in Dependency-Track, even though it shouldn't: %v", username, user)
+		}
+
+		return nil
+	}
+}
+
+func TestAccCheckUserHasExpectedPermissions(ctx context.Context, testDependencyTrack *testutils.TestDependencyTrack, username string, expectedPermissions []string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		permissions, err := FindUserPermissions(ctx, testDependencyTrack, username)
+		if err != nil {
+			return err
+		}
+
+		actualPermissions := make([]string, len(permissions))
+		for i, permission := range permissions {
+			actualPermissions[i] = permission.Name
+		}
+
+		diff := cmp.Diff(expectedPermissions, actualPermissions, cmpopts.SortSlices(func(a, b string) bool { return a < b }), cmpopts.EquateEmpty())
+		if diff != "" {
+			return fmt.Errorf("permissions of user %s are different than expected: %s", username, diff)
+		}
+
+		return nil
+	}
+}
+
+// FindUserPermissions returns the permissions granted directly to the managed, LDAP or OIDC user with username.
+func FindUserPermissions(ctx context.Context, testDependencyTrack *testutils.TestDependencyTrack, username string) ([]dtrack.Permission, error) {
+	managedUser, err := FindManagedUser(ctx, testDependencyTrack, username)
+	if err != nil {
+		return nil, err
+	}
+	if managedUser != nil {
+		return managedUser.Permissions, nil
+	}
+
+	ldapUser, err := FindLDAPUser(ctx, testDependencyTrack, username)
+	if err != nil {
+		return nil, err
+	}
+	if ldapUser != nil {
+		return ldapUser.Permissions, nil
+	}
+
+	oidcUser, err := FindOIDCUser(ctx, testDependencyTrack, username)
+	if err != nil {
+		return nil, err
+	}
+	if oidcUser != nil {
+		return oidcUser.Permissions, nil
+	}
+
+	return nil, fmt.Errorf("user %s does not exist in Dependency-Track", username)
+}
+
+func FindManagedUser(ctx context.Context, testDependencyTrack *testutils.TestDependencyTrack, username string) (*dtrack.ManagedUser, error) {
+	users, err := dtrack.FetchAll(func(po dtrack.PageOptions) (dtrack.Page[dtrack.ManagedUser], error) {
+		return testDependencyTrack.Client.User.GetAllManaged(ctx, po)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get managed users from Dependency-Track: %w", err)
+	}
+
+	for _, user := range users {
+		if user.Username == username {
+			return &user, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func FindLDAPUser(ctx context.Context, testDependencyTrack *testutils.TestDependencyTrack, username string) (*dtrack.LDAPUser, error) {
+	users, err := dtrack.FetchAll(func(po dtrack.PageOptions) (dtrack.Page[dtrack.LDAPUser], error) {
+		return testDependencyTrack.Client.User.GetAllLDAP(ctx, po)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get LDAP users from Dependency-Track: %w", err)
+	}
+
+	for _, user := range users {
+		if user.Username == username {
+			return &user, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func FindOIDCUser(ctx context.Context, testDependencyTrack *testutils.TestDependencyTrack, username string) (*dtrack.OIDCUser, error) {
+	users, err := dtrack.FetchAll(func(po dtrack.PageOptions) (dtrack.Page[dtrack.OIDCUser], error) {
+		return testDependencyTrack.Client.User.GetAllOIDC(ctx, po)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get OIDC users from Dependency-Track: %w", err)
+	}
+
+	for _, user := range users {
+		if user.Username == username {
+			return &user, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func CreateManagedUserResourceName(localName string) string {
+	return "dependencytrack_managed_user." + localName
+}
+
+func CreateLDAPUserResourceName(localName string) string {
+	return "dependencytrack_ldap_user." + localName
+}
+
+func CreateOIDCUserResourceName(localName string) string {
+	return "dependencytrack_oidc_user." + localName
+}
+
+func CreateUserPermissionResourceName(localName string) string {
+	return "dependencytrack_user_permission." + localName
+}
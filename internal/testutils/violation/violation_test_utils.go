@@ -0,0 +1,169 @@
+// Copyright (c) 2024 Futurice Oy
+// SPDX-License-Identifier: MPL-2.0
+
+package violationtestutils
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	dtrack "github.com/futurice/dependency-track-client-go"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/testutils"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+// violationTimeout is how long to wait for Dependency-Track to evaluate the policies of a new component.
+const violationTimeout = 60 * time.Second
+
+// Violation identifies a policy violation of a component in a project.
+type Violation struct {
+	ProjectID     uuid.UUID
+	ComponentID   uuid.UUID
+	ComponentPURL string
+	PolicyName    string
+	ViolationID   uuid.UUID
+}
+
+// CreateTestPolicyViolation creates a project with a component violating an operational policy of the project, and
+// waits for Dependency-Track to report the violation. The project and the policy are deleted when the test is done.
+// There are no resources for components, so they cannot be created in the test configuration. A zero Violation is
+// returned if acceptance tests are not enabled, as there is no Dependency-Track to create them in, and
+// resource.Test skips the test anyway.
+func CreateTestPolicyViolation(ctx context.Context, t *testing.T, testDependencyTrack *testutils.TestDependencyTrack) Violation {
+	t.Helper()
+
+	if testDependencyTrack == nil {
+		return Violation{}
+	}
+
+	client := testDependencyTrack.Client
+
+	project, err := client.Project.Create(ctx, dtrack.Project{
+		Name:   acctest.RandomWithPrefix("test-project"),
+		Active: true,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create project: %v", err)
+	}
+	t.Cleanup(func() {
+		if err := client.Project.Delete(ctx, project.UUID); err != nil {
+			t.Errorf("Failed to delete project %s: %v", project.UUID, err)
+		}
+	})
+
+	componentName := acctest.RandomWithPrefix("test-component")
+	componentPURL := fmt.Sprintf("pkg:generic/%s@1.0.0", componentName)
+
+	policy, err := client.Policy.Create(ctx, dtrack.Policy{
+		Name:           acctest.RandomWithPrefix("test-policy"),
+		Operator:       "ANY",
+		ViolationState: "WARN",
+	})
+	if err != nil {
+		t.Fatalf("Failed to create policy: %v", err)
+	}
+	t.Cleanup(func() {
+		if err := client.Policy.Delete(ctx, policy.UUID); err != nil {
+			t.Errorf("Failed to delete policy %s: %v", policy.UUID, err)
+		}
+	})
+
+	_, err = client.PolicyCondition.Create(ctx, policy.UUID, dtrack.PolicyCondition{
+		Subject:  "PACKAGE_URL",
+		Operator: "MATCHES",
+		Value:    componentPURL,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create policy condition: %v", err)
+	}
+
+	// Limit the policy to the project, so that it does not affect the other tests
+	_, err = client.Policy.AddProject(ctx, policy.UUID, project.UUID)
+	if err != nil {
+		t.Fatalf("Failed to add project to policy: %v", err)
+	}
+
+	// Creating the component triggers the evaluation of the policies
+	component, err := client.Component.Create(ctx, project.UUID, dtrack.Component{
+		Name:    componentName,
+		Version: "1.0.0",
+		PURL:    componentPURL,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create component: %v", err)
+	}
+
+	deadline := time.Now().Add(violationTimeout)
+	for {
+		violations, err := dtrack.FetchAll(func(po dtrack.PageOptions) (dtrack.Page[dtrack.PolicyViolation], error) {
+			return client.PolicyViolation.GetAllForProject(ctx, project.UUID, true, po)
+		})
+		if err != nil {
+			t.Fatalf("Failed to get policy violations: %v", err)
+		}
+
+		for _, violation := range violations {
+			if violation.Component.UUID == component.UUID {
+				return Violation{
+					ProjectID:     project.UUID,
+					ComponentID:   component.UUID,
+					ComponentPURL: componentPURL,
+					PolicyName:    policy.Name,
+					ViolationID:   violation.UUID,
+				}
+			}
+		}
+
+		if time.Now().After(deadline) {
+			t.Fatalf("Component %s did not violate policy %s within %s", componentPURL, policy.Name, violationTimeout)
+		}
+		time.Sleep(time.Second)
+	}
+}
+
+func TestAccCheckViolationAnalysisHasExpectedData(ctx context.Context, testDependencyTrack *testutils.TestDependencyTrack, violation Violation, expectedState string, expectedSuppressed bool) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		analysis, err := testDependencyTrack.Client.ViolationAnalysis.Get(ctx, violation.ComponentID, violation.ViolationID)
+		if err != nil {
+			return fmt.Errorf("failed to get analysis of policy violation %s from Dependency-Track: %w", violation.ViolationID, err)
+		}
+
+		if string(analysis.State) != expectedState || analysis.Suppressed != expectedSuppressed {
+			return fmt.Errorf("analysis of policy violation %s is %s, suppressed %t, expected %s, suppressed %t",
+				violation.ViolationID, analysis.State, analysis.Suppressed, expectedState, expectedSuppressed)
+		}
+
+		return nil
+	}
+}
+
+// TestAccCheckViolationAnalysisHasComment checks that the comment has been added to the audit trail of the violation.
+func TestAccCheckViolationAnalysisHasComment(ctx context.Context, testDependencyTrack *testutils.TestDependencyTrack, violation Violation, expectedComment string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		analysis, err := testDependencyTrack.Client.ViolationAnalysis.Get(ctx, violation.ComponentID, violation.ViolationID)
+		if err != nil {
+			return fmt.Errorf("failed to get analysis of policy violation %s from Dependency-Track: %w", violation.ViolationID, err)
+		}
+
+		for _, comment := range analysis.Comments {
+			if comment.Comment == expectedComment {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("analysis of policy violation %s does not have the comment %q", violation.ViolationID, expectedComment)
+	}
+}
+
+func CreateViolationAnalysisResourceName(localName string) string {
+	return "dependencytrack_violation_analysis." + localName
+}
+
+func CreatePolicyViolationDataSourceName(localName string) string {
+	return "data.dependencytrack_policy_violation." + localName
+}
@@ -0,0 +1,85 @@
+// Copyright (c) 2024 Futurice Oy
+// SPDX-License-Identifier: MPL-2.0
+
+package vulnerabilitytestutils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	dtrack "github.com/futurice/dependency-track-client-go"
+	"github.com/futurice/terraform-provider-dependencytrack/internal/testutils"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccCheckVulnerabilityExistsAndHasExpectedSeverity(ctx context.Context, testDependencyTrack *testutils.TestDependencyTrack, resourceName, expectedSeverity string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		vulnerability, err := FindVulnerabilityByResourceName(ctx, testDependencyTrack, state, resourceName)
+		if err != nil {
+			return err
+		}
+		if vulnerability == nil {
+			return fmt.Errorf("vulnerability for resource %s does not exist in Dependency-Track", resourceName)
+		}
+
+		if vulnerability.Source != "INTERNAL" {
+			return fmt.Errorf("vulnerability for resource %s has source %s instead of INTERNAL", resourceName, vulnerability.Source)
+		}
+		if vulnerability.Severity != expectedSeverity {
+			return fmt.Errorf("vulnerability for resource %s has severity %s instead of %s", resourceName, vulnerability.Severity, expectedSeverity)
+		}
+
+		return nil
+	}
+}
+
+func TestAccCheckVulnerabilityDoesNotExists(ctx context.Context, testDependencyTrack *testutils.TestDependencyTrack, resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		vulnerability, err := FindVulnerabilityByResourceName(ctx, testDependencyTrack, state, resourceName)
+		if err != nil {
+			return err
+		}
+		if vulnerability != nil {
+			return fmt.Errorf("vulnerability for resource %s exists in Dependency-Track, even though it shouldn't: %v", resourceName, vulnerability)
+		}
+
+		return nil
+	}
+}
+
+func FindVulnerabilityByResourceName(ctx context.Context, testDependencyTrack *testutils.TestDependencyTrack, state *terraform.State, resourceName string) (*dtrack.Vulnerability, error) {
+	vulnerabilityID, err := testutils.GetResourceID(state, resourceName)
+	if err != nil {
+		return nil, err
+	}
+
+	vulnerability, err := FindVulnerability(ctx, testDependencyTrack, vulnerabilityID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get vulnerability for resource %s: %w", resourceName, err)
+	}
+
+	return vulnerability, nil
+}
+
+func FindVulnerability(ctx context.Context, testDependencyTrack *testutils.TestDependencyTrack, vulnerabilityID uuid.UUID) (*dtrack.Vulnerability, error) {
+	vulnerability, err := testDependencyTrack.Client.Vulnerability.Get(ctx, vulnerabilityID)
+	if err != nil {
+		var apiErr *dtrack.APIError
+		ok := errors.As(err, &apiErr)
+		if !ok || apiErr.StatusCode != http.StatusNotFound {
+			return nil, fmt.Errorf("failed to get vulnerability from Dependency-Track: %w", err)
+		}
+
+		return nil, nil
+	}
+
+	return &vulnerability, nil
+}
+
+func CreateVulnerabilityResourceName(localName string) string {
+	return "dependencytrack_vulnerability." + localName
+}
@@ -0,0 +1,47 @@
+// Copyright (c) 2024 Futurice Oy
+// SPDX-License-Identifier: MPL-2.0
+
+package utils
+
+import (
+	"fmt"
+	"math/big"
+	"net/url"
+	"strconv"
+
+	"github.com/google/uuid"
+)
+
+// HiddenPropertyValue is returned by the API instead of the value of ENCRYPTEDSTRING properties.
+const HiddenPropertyValue = "HiddenDecryptedPropertyPlaceholder"
+
+// PropertyTypes are the types of project and config properties in Dependency-Track.
+var PropertyTypes = []string{"BOOLEAN", "INTEGER", "NUMBER", "STRING", "ENCRYPTEDSTRING", "TIMESTAMP", "URL", "UUID"}
+
+// ValidatePropertyValue returns an error if the server would reject value for a property of propertyType.
+func ValidatePropertyValue(propertyType, value string) error {
+	switch propertyType {
+	case "BOOLEAN":
+		if value != "true" && value != "false" {
+			return fmt.Errorf("expected true or false, got [%s]", value)
+		}
+	case "INTEGER":
+		if _, err := strconv.ParseInt(value, 10, 32); err != nil {
+			return fmt.Errorf("expected an integer, got [%s]", value)
+		}
+	case "NUMBER":
+		if _, ok := new(big.Float).SetString(value); !ok {
+			return fmt.Errorf("expected a number, got [%s]", value)
+		}
+	case "URL":
+		if parsed, err := url.Parse(value); err != nil || parsed.Scheme == "" {
+			return fmt.Errorf("expected an absolute URL, got [%s]", value)
+		}
+	case "UUID":
+		if _, err := uuid.Parse(value); err != nil {
+			return fmt.Errorf("expected a UUID, got [%s]", value)
+		}
+	}
+
+	return nil
+}
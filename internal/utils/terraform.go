@@ -8,6 +8,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // ParseUUID parses the UUID value returning a possible error as Diagnostics instead of error.
@@ -35,3 +36,13 @@ func ParseAttributeUUID(uuidString string, attributePath string) (uuid.UUID, dia
 
 	return id, diags
 }
+
+// StringValueOrNull returns the value as a String, or a null String if the value is empty. Dependency-Track returns
+// unset optional strings as empty, while they are null in the Terraform configuration.
+func StringValueOrNull(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+
+	return types.StringValue(value)
+}
@@ -56,3 +56,41 @@ func TestParseAttributeUUID_invalid(t *testing.T) {
 		t.Errorf("Diags do not contain the expected attribute error: %v", diags)
 	}
 }
+
+func TestValidatePropertyValue_valid(t *testing.T) {
+	testCases := map[string][]string{
+		"BOOLEAN":         {"true", "false"},
+		"INTEGER":         {"0", "-42", "2147483647"},
+		"NUMBER":          {"1", "3.14", "-1e10"},
+		"STRING":          {"", "anything"},
+		"ENCRYPTEDSTRING": {"secret"},
+		"URL":             {"https://example.com/path"},
+		"UUID":            {"8ffb30fb-77e6-4886-9f32-ff142f9bf90b"},
+	}
+
+	for propertyType, values := range testCases {
+		for _, value := range values {
+			if err := utils.ValidatePropertyValue(propertyType, value); err != nil {
+				t.Errorf("Unexpected error for %s value [%s]: %v", propertyType, value, err)
+			}
+		}
+	}
+}
+
+func TestValidatePropertyValue_invalid(t *testing.T) {
+	testCases := map[string][]string{
+		"BOOLEAN": {"yes", "TRUE", ""},
+		"INTEGER": {"1.5", "abc", "2147483648"},
+		"NUMBER":  {"abc", ""},
+		"URL":     {"example.com", "://"},
+		"UUID":    {"not-an-UUID"},
+	}
+
+	for propertyType, values := range testCases {
+		for _, value := range values {
+			if err := utils.ValidatePropertyValue(propertyType, value); err == nil {
+				t.Errorf("Error expected for %s value [%s], but received none", propertyType, value)
+			}
+		}
+	}
+}
@@ -0,0 +1,54 @@
+// Copyright (c) 2024 Futurice Oy
+// SPDX-License-Identifier: MPL-2.0
+
+package validators
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = cpeValidator{}
+
+var (
+	// cpe23Regex matches a CPE 2.3 formatted string, as given in the CPE naming specification (NISTIR 7695).
+	cpe23Regex = regexp.MustCompile(`^cpe:2\.3:[aho*\-](:(((\?*|\*?)([a-zA-Z0-9\-._]|(\\[\\*?!"#$%&'()+,/:;<=>@\[\]^` + "`" + `{|}~]))+(\?*|\*?))|[*\-])){5}(:(([a-zA-Z]{2,3}(-([a-zA-Z]{2}|[0-9]{3}))?)|[*\-]))(:(((\?*|\*?)([a-zA-Z0-9\-._]|(\\[\\*?!"#$%&'()+,/:;<=>@\[\]^` + "`" + `{|}~]))+(\?*|\*?))|[*\-])){4}$`)
+	// cpe22Regex matches a CPE 2.2 URI, which Dependency-Track also accepts.
+	cpe22Regex = regexp.MustCompile(`^[cC][pP][eE]:/[AHOaho]?(:[A-Za-z0-9._\-~%]*){0,6}$`)
+)
+
+type cpeValidator struct{}
+
+// CPE returns a validator checking that the value is a CPE 2.3 formatted string or a CPE 2.2 URI.
+func CPE() validator.String {
+	return cpeValidator{}
+}
+
+func (v cpeValidator) Description(ctx context.Context) string {
+	return "value must be a CPE 2.3 formatted string or a CPE 2.2 URI, e.g. cpe:2.3:a:example:app:1.0.0:*:*:*:*:*:*:*"
+}
+
+func (v cpeValidator) MarkdownDescription(ctx context.Context) string {
+	return "value must be a CPE 2.3 formatted string or a CPE 2.2 URI, e.g. `cpe:2.3:a:example:app:1.0.0:*:*:*:*:*:*:*`"
+}
+
+func (v cpeValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if !IsCPE(req.ConfigValue.ValueString()) {
+		resp.Diagnostics.AddAttributeError(req.Path,
+			"Invalid CPE",
+			fmt.Sprintf("The value [%s] is not a valid CPE 2.3 formatted string or CPE 2.2 URI.", req.ConfigValue.ValueString()),
+		)
+	}
+}
+
+// IsCPE tells whether cpe is a CPE 2.3 formatted string or a CPE 2.2 URI.
+func IsCPE(cpe string) bool {
+	return cpe23Regex.MatchString(cpe) || cpe22Regex.MatchString(cpe)
+}
@@ -0,0 +1,79 @@
+// Copyright (c) 2024 Futurice Oy
+// SPDX-License-Identifier: MPL-2.0
+
+package validators
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = purlValidator{}
+
+// purlTypeRegex matches the type of a package URL, see https://github.com/package-url/purl-spec.
+var purlTypeRegex = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9.+-]*$`)
+
+type purlValidator struct{}
+
+// PURL returns a validator checking that the value is a package URL (PURL), e.g. pkg:maven/org.example/app@1.0.0.
+func PURL() validator.String {
+	return purlValidator{}
+}
+
+func (v purlValidator) Description(ctx context.Context) string {
+	return "value must be a package URL (PURL), e.g. pkg:maven/org.example/app@1.0.0"
+}
+
+func (v purlValidator) MarkdownDescription(ctx context.Context) string {
+	return "value must be a package URL (PURL), e.g. `pkg:maven/org.example/app@1.0.0`"
+}
+
+func (v purlValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := ValidatePURL(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path,
+			"Invalid Package URL",
+			fmt.Sprintf("The value [%s] is not a valid package URL: %s.", req.ConfigValue.ValueString(), err),
+		)
+	}
+}
+
+// ValidatePURL returns an error describing why purl is not a valid package URL, or nil if it is valid.
+func ValidatePURL(purl string) error {
+	remainder, found := strings.CutPrefix(purl, "pkg:")
+	if !found {
+		return fmt.Errorf("the scheme must be pkg")
+	}
+
+	// The subpath and the qualifiers are optional and do not affect the validity of the rest
+	remainder, _, _ = strings.Cut(remainder, "#")
+	remainder, qualifiers, _ := strings.Cut(remainder, "?")
+	if _, err := url.ParseQuery(qualifiers); err != nil {
+		return fmt.Errorf("the qualifiers are invalid: %w", err)
+	}
+
+	remainder = strings.TrimLeft(remainder, "/")
+	purlType, remainder, found := strings.Cut(remainder, "/")
+	if !found {
+		return fmt.Errorf("the name is missing")
+	}
+	if !purlTypeRegex.MatchString(purlType) {
+		return fmt.Errorf("the type [%s] must start with a letter and contain only letters, numbers, '.', '+' and '-'", purlType)
+	}
+
+	namespaceAndName, _, _ := strings.Cut(remainder, "@")
+	name := namespaceAndName[strings.LastIndex(namespaceAndName, "/")+1:]
+	if name == "" {
+		return fmt.Errorf("the name is missing")
+	}
+
+	return nil
+}
@@ -0,0 +1,52 @@
+// Copyright (c) 2024 Futurice Oy
+// SPDX-License-Identifier: MPL-2.0
+
+package validators
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = spdxLicenseIDValidator{}
+
+// spdxLicenseIDRegex matches an SPDX license identifier, which consists of letters, digits, dots and hyphens. The
+// trailing plus is allowed for the deprecated "or later" identifiers such as GPL-2.0+.
+var spdxLicenseIDRegex = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9.-]*\+?$`)
+
+type spdxLicenseIDValidator struct{}
+
+// SPDXLicenseID returns a validator checking that the value is an SPDX license identifier, e.g. Apache-2.0.
+func SPDXLicenseID() validator.String {
+	return spdxLicenseIDValidator{}
+}
+
+func (v spdxLicenseIDValidator) Description(ctx context.Context) string {
+	return "value must be an SPDX license identifier, e.g. Apache-2.0"
+}
+
+func (v spdxLicenseIDValidator) MarkdownDescription(ctx context.Context) string {
+	return "value must be an SPDX license identifier, e.g. `Apache-2.0`"
+}
+
+func (v spdxLicenseIDValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if !IsSPDXLicenseID(req.ConfigValue.ValueString()) {
+		resp.Diagnostics.AddAttributeError(req.Path,
+			"Invalid SPDX License Identifier",
+			fmt.Sprintf("The value [%s] is not a valid SPDX license identifier.", req.ConfigValue.ValueString()),
+		)
+	}
+}
+
+// IsSPDXLicenseID tells whether id is syntactically an SPDX license identifier. It does not check that the license
+// is on the SPDX license list.
+func IsSPDXLicenseID(id string) bool {
+	return spdxLicenseIDRegex.MatchString(id)
+}
@@ -0,0 +1,161 @@
+// Copyright (c) 2024 Futurice Oy
+// SPDX-License-Identifier: MPL-2.0
+
+package validators_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/futurice/terraform-provider-dependencytrack/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestPURL(t *testing.T) {
+	testCases := map[string]bool{
+		"pkg:maven/org.example/app@1.0.0":                                       true,
+		"pkg:npm/%40angular/core@17.0.0":                                        true,
+		"pkg:golang/github.com/google/uuid@v1.6.0":                              true,
+		"pkg:deb/debian/curl@7.50.3-1?arch=i386&distro=jessie":                  true,
+		"pkg:github/package-url/purl-spec@244fd47e07d1004#everybody/loves/dogs": true,
+		"pkg:generic/app":              true,
+		"maven/org.example/app@1.0.0":  false,
+		"pkg:maven":                    false,
+		"pkg:1maven/org.example/app":   false,
+		"pkg:.maven/org.example/app":   false,
+		"pkg:+maven/org.example/app":   false,
+		"pkg:-maven/org.example/app":   false,
+		"pkg:maven/org.example/@1.0.0": false,
+		"https://example.com/app":      false,
+	}
+
+	for value, valid := range testCases {
+		assertValidation(t, validators.PURL(), value, valid)
+	}
+}
+
+func TestCPE(t *testing.T) {
+	testCases := map[string]bool{
+		"cpe:2.3:a:example:app:1.0.0:*:*:*:*:*:*:*":        true,
+		"cpe:2.3:o:microsoft:windows_10:-:*:*:*:*:*:x64:*": true,
+		"cpe:2.3:a:example:my\\!app:*:*:*:en-us:*:*:*:*":   true,
+		"cpe:/a:example:app:1.0.0":                         true,
+		"cpe:2.3:a:example:app":                            false,
+		"cpe:2.3:x:example:app:1.0.0:*:*:*:*:*:*:*":        false,
+		"cpe:/x:example:app":                               false,
+		"example:app:1.0.0":                                false,
+	}
+
+	for value, valid := range testCases {
+		assertValidation(t, validators.CPE(), value, valid)
+	}
+}
+
+func TestSPDXLicenseID(t *testing.T) {
+	testCases := map[string]bool{
+		"Apache-2.0":             true,
+		"MIT":                    true,
+		"GPL-2.0+":               true,
+		"BSD-3-Clause":           true,
+		"LicenseRef-Proprietary": true,
+		"Apache 2.0":             false,
+		"-MIT":                   false,
+		"GPL-2.0++":              false,
+		"MIT OR Apache-2.0":      false,
+		"":                       false,
+	}
+
+	for value, valid := range testCases {
+		assertValidation(t, validators.SPDXLicenseID(), value, valid)
+	}
+}
+
+func TestCVSSv2Vector(t *testing.T) {
+	testCases := map[string]bool{
+		"AV:N/AC:L/Au:N/C:P/I:P/A:P":                   true,
+		"(AV:N/AC:L/Au:N/C:N/I:N/A:N)":                 true,
+		"AV:L/AC:H/Au:M/C:C/I:C/A:C/E:POC/RL:OF/RC:C":  true,
+		"(AV:N/AC:L/Au:N/C:N/I:N/A:N":                  false,
+		"AV:N/AC:L/Au:N/C:N/I:N/A:N)":                  false,
+		"AV:N/AC:L/Au:N/C:P/I:P":                       false,
+		"AV:X/AC:L/Au:N/C:P/I:P/A:P":                   false,
+		"AC:L/AV:N/Au:N/C:P/I:P/A:P":                   false,
+		"CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H": false,
+		"": false,
+	}
+
+	for value, valid := range testCases {
+		assertValidation(t, validators.CVSSv2Vector(), value, valid)
+	}
+}
+
+func TestCVSSv3Vector(t *testing.T) {
+	testCases := map[string]bool{
+		"CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H":                           true,
+		"CVSS:3.0/AV:P/AC:H/PR:H/UI:R/S:C/C:N/I:L/A:N":                           true,
+		"CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H/E:F/RL:O/RC:C":             true,
+		"CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H/E:P":                       true,
+		"CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H/RC:R/CR:H/MAV:L/MS:C/MA:N": true,
+		"CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H/RL:O/E:F":                  false,
+		"CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H/MAV:Z":                     false,
+		"AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H":                                    false,
+		"CVSS:4.0/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H":                           false,
+		"CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H":                               false,
+		"CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:X/C:H/I:H/A:H":                           false,
+		"AV:N/AC:L/Au:N/C:P/I:P/A:P":                                             false,
+	}
+
+	for value, valid := range testCases {
+		assertValidation(t, validators.CVSSv3Vector(), value, valid)
+	}
+}
+
+func TestOWASPRiskRatingVector(t *testing.T) {
+	testCases := map[string]bool{
+		"SL:1/M:1/O:0/S:2/ED:1/EE:1/A:1/ID:1/LC:2/LI:1/LAV:1/LAC:1/FD:1/RD:1/NC:2/PV:3":  true,
+		"SL:9/M:9/O:9/S:9/ED:9/EE:9/A:9/ID:9/LC:9/LI:9/LAV:9/LAC:9/FD:9/RD:9/NC:9/PV:9":  true,
+		"SL:1/M:1/O:0/S:2/ED:1/EE:1/A:1/ID:1/LC:2/LI:1/LAV:1/LAC:1/FD:1/RD:1/NC:2":       false,
+		"SL:10/M:1/O:0/S:2/ED:1/EE:1/A:1/ID:1/LC:2/LI:1/LAV:1/LAC:1/FD:1/RD:1/NC:2/PV:3": false,
+		"M:1/SL:1/O:0/S:2/ED:1/EE:1/A:1/ID:1/LC:2/LI:1/LAV:1/LAC:1/FD:1/RD:1/NC:2/PV:3":  false,
+	}
+
+	for value, valid := range testCases {
+		assertValidation(t, validators.OWASPRiskRatingVector(), value, valid)
+	}
+}
+
+func TestValidators_nullAndUnknown(t *testing.T) {
+	for _, v := range []validator.String{
+		validators.PURL(), validators.CPE(), validators.SPDXLicenseID(),
+		validators.CVSSv2Vector(), validators.CVSSv3Vector(), validators.OWASPRiskRatingVector(),
+	} {
+		for _, value := range []types.String{types.StringNull(), types.StringUnknown()} {
+			resp := validator.StringResponse{}
+			v.ValidateString(context.Background(), validator.StringRequest{Path: path.Root("test"), ConfigValue: value}, &resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Errorf("Unexpected error for %s: %v", value, resp.Diagnostics)
+			}
+		}
+	}
+}
+
+func assertValidation(t *testing.T, v validator.String, value string, valid bool) {
+	t.Helper()
+
+	resp := validator.StringResponse{}
+	v.ValidateString(context.Background(), validator.StringRequest{
+		Path:        path.Root("test"),
+		ConfigValue: types.StringValue(value),
+	}, &resp)
+
+	if valid && resp.Diagnostics.HasError() {
+		t.Errorf("Unexpected error for [%s]: %v", value, resp.Diagnostics)
+	}
+
+	if !valid && !resp.Diagnostics.HasError() {
+		t.Errorf("Error expected for [%s], but received none", value)
+	}
+}
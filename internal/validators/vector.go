@@ -0,0 +1,86 @@
+// Copyright (c) 2024 Futurice Oy
+// SPDX-License-Identifier: MPL-2.0
+
+package validators
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = vectorValidator{}
+
+// cvssV2Vector matches the base metrics of a CVSS v2 vector, optionally followed by the temporal metrics.
+const cvssV2Vector = `AV:[LAN]/AC:[HML]/Au:[MSN]/C:[NPC]/I:[NPC]/A:[NPC](/E:(U|POC|F|H|ND)/RL:(OF|TF|W|U|ND)/RC:(UC|UR|C|ND))?`
+
+// cvssV2VectorRegex matches a CVSS v2 vector, which may be enclosed in parentheses, as Dependency-Track does.
+var cvssV2VectorRegex = regexp.MustCompile(`^(\(` + cvssV2Vector + `\)|` + cvssV2Vector + `)$`)
+
+// cvssV3VectorRegex matches the base metrics of a CVSS v3.0 or v3.1 vector, followed by any of the temporal and
+// environmental metrics in the order of the specification.
+var cvssV3VectorRegex = regexp.MustCompile(`^CVSS:3\.[01]/AV:[NALP]/AC:[LH]/PR:[NLH]/UI:[NR]/S:[UC]/C:[NLH]/I:[NLH]/A:[NLH]` +
+	`(/E:[XUPFH])?(/RL:[XOTWU])?(/RC:[XURC])?` +
+	`(/CR:[XLMH])?(/IR:[XLMH])?(/AR:[XLMH])?` +
+	`(/MAV:[XNALP])?(/MAC:[XLH])?(/MPR:[XNLH])?(/MUI:[XNR])?(/MS:[XUC])?(/MC:[XNLH])?(/MI:[XNLH])?(/MA:[XNLH])?$`)
+
+// owaspRiskRatingVectorRegex matches the 16 factors of the OWASP Risk Rating Methodology, each rated from 0 to 9.
+var owaspRiskRatingVectorRegex = regexp.MustCompile(`^SL:\d/M:\d/O:\d/S:\d/ED:\d/EE:\d/A:\d/ID:\d/LC:\d/LI:\d/LAV:\d/LAC:\d/FD:\d/RD:\d/NC:\d/PV:\d$`)
+
+type vectorValidator struct {
+	name    string
+	example string
+	regex   *regexp.Regexp
+}
+
+// CVSSv2Vector returns a validator checking that the value is a CVSS v2 vector, e.g. AV:N/AC:L/Au:N/C:P/I:P/A:P.
+func CVSSv2Vector() validator.String {
+	return vectorValidator{
+		name:    "CVSS v2 vector",
+		example: "AV:N/AC:L/Au:N/C:P/I:P/A:P",
+		regex:   cvssV2VectorRegex,
+	}
+}
+
+// CVSSv3Vector returns a validator checking that the value is a CVSS v3.0 or v3.1 vector, e.g.
+// CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H.
+func CVSSv3Vector() validator.String {
+	return vectorValidator{
+		name:    "CVSS v3 vector",
+		example: "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H",
+		regex:   cvssV3VectorRegex,
+	}
+}
+
+// OWASPRiskRatingVector returns a validator checking that the value is an OWASP Risk Rating vector, e.g.
+// SL:1/M:1/O:0/S:2/ED:1/EE:1/A:1/ID:1/LC:2/LI:1/LAV:1/LAC:1/FD:1/RD:1/NC:2/PV:3.
+func OWASPRiskRatingVector() validator.String {
+	return vectorValidator{
+		name:    "OWASP Risk Rating vector",
+		example: "SL:1/M:1/O:0/S:2/ED:1/EE:1/A:1/ID:1/LC:2/LI:1/LAV:1/LAC:1/FD:1/RD:1/NC:2/PV:3",
+		regex:   owaspRiskRatingVectorRegex,
+	}
+}
+
+func (v vectorValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("value must be a %s, e.g. %s", v.name, v.example)
+}
+
+func (v vectorValidator) MarkdownDescription(ctx context.Context) string {
+	return fmt.Sprintf("value must be a %s, e.g. `%s`", v.name, v.example)
+}
+
+func (v vectorValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if !v.regex.MatchString(req.ConfigValue.ValueString()) {
+		resp.Diagnostics.AddAttributeError(req.Path,
+			"Invalid Vector",
+			fmt.Sprintf("The value [%s] is not a valid %s, e.g. %s.", req.ConfigValue.ValueString(), v.name, v.example),
+		)
+	}
+}